@@ -0,0 +1,305 @@
+package txt
+
+import (
+  "bufio"
+  "io"
+  "unicode"
+  "unicode/utf8"
+)
+
+type MatchKind int
+
+const (
+  LeftmostLongest MatchKind = iota // non-overlapping, longest of those starting first
+  Overlapping                      // every occurrence of every pattern
+)
+
+type MatcherOptions struct {
+  Kind     MatchKind
+  FoldCase bool      // match under Unicode simple case folding
+}
+
+// Match is an occurrence of patterns[Pattern] at the byte span [Start, End)
+type Match struct {
+  Pattern    int
+  Start, End int
+}
+
+// Matcher finds many literal patterns in one pass with an Aho-Corasick
+// automaton compiled into a table over the bytes used by the patterns.
+// Empty patterns never match.
+type Matcher struct {
+  opt    MatcherOptions
+  class  [256]byte
+  nclass int
+  trans  []int32 // trans[state*nclass + class]
+  out    []int   // pattern ending at the state, -1 for none
+  dict   []int32 // next state in the failure chain with an output, 0 for none
+  depth  []int
+  maxLen int
+}
+
+func NewMatcher( patterns []string, opt MatcherOptions ) *Matcher {
+  m := &Matcher{ opt: opt }
+
+  keys := make( []string, len( patterns ) )
+  for i, p := range patterns {
+    keys[i] = p
+    if opt.FoldCase { keys[i] = string( foldBytes( p ) ) }
+
+    if len( keys[i] ) > m.maxLen { m.maxLen = len( keys[i] ) }
+    for j := 0; j < len( keys[i] ); j++ {
+      if m.class[keys[i][j]] == 0 {
+        m.nclass++
+        m.class[keys[i][j]] = byte( m.nclass )
+      }
+    }
+  }
+  m.nclass++
+
+  m.newState( 0 )
+  for i, k := range keys {
+    if k == "" { continue }
+
+    s := int32( 0 )
+    for j := 0; j < len( k ); j++ {
+      t := int( s ) * m.nclass + int( m.class[k[j]] )
+      if m.trans[t] == 0 { m.trans[t] = m.newState( j + 1 ) }
+      s = m.trans[t]
+    }
+
+    if m.out[s] < 0 { m.out[s] = i }
+  }
+
+  m.link()
+
+  return m
+}
+
+func (m *Matcher) newState( depth int ) int32 {
+  for i := 0; i < m.nclass; i++ {
+    m.trans = append( m.trans, 0 )
+  }
+  m.out   = append( m.out, -1 )
+  m.dict  = append( m.dict, 0 )
+  m.depth = append( m.depth, depth )
+
+  return int32( len( m.out ) - 1 )
+}
+
+// link completes the trie into a DFA following failure links breadth first
+func (m *Matcher) link() {
+  n     := m.nclass
+  fail  := make( []int32, len( m.out ) )
+  queue := make( []int32, 0, len( m.out ) )
+
+  for c := 0; c < n; c++ {
+    if t := m.trans[c]; t > 0 { queue = append( queue, t ) }
+  }
+
+  for len( queue ) > 0 {
+    s := queue[0]
+    queue = queue[1:]
+
+    for c := 0; c < n; c++ {
+      t := m.trans[int( s ) * n + c]
+      f := m.trans[int( fail[s] ) * n + c]
+
+      if t > 0 {
+        fail[t] = f
+        m.dict[t] = m.dict[f]
+        if m.out[f] >= 0 { m.dict[t] = f }
+        queue = append( queue, t )
+      } else {
+        m.trans[int( s ) * n + c] = f
+      }
+    }
+  }
+}
+
+func (m *Matcher) FindAll( str string ) []Match {
+  r := make( []Match, 0, 16 )
+  s := m.newScanner( func( match Match ) bool {
+    r = append( r, match )
+    return true
+  })
+
+  if m.opt.FoldCase {
+    for i := 0; i < len( str ); {
+      c, w := utf8.DecodeRuneInString( str[i:] )
+      s.feedRune( c, str[i:i + w], i, i + w )
+      i += w
+    }
+  } else {
+    for i := 0; i < len( str ); i++ {
+      s.step( str[i], i, i + 1 )
+    }
+  }
+
+  s.flush()
+
+  return r
+}
+
+// FindReader calls fn with each match read from rd, offsets counted in bytes
+// from the start of rd, until fn returns false or rd is exhausted
+func (m *Matcher) FindReader( rd io.Reader, fn func( Match ) bool ) error {
+  s := m.newScanner( fn )
+
+  if m.opt.FoldCase {
+    br := bufio.NewReader( rd )
+    for off := 0; !s.stop; {
+      c, w, err := br.ReadRune()
+      if err == io.EOF { break }
+      if err != nil { return err }
+
+      raw := string( c )
+      if c == utf8.RuneError && w == 1 {
+        br.UnreadRune()
+        b, _ := br.ReadByte()
+        raw = string( []byte{ b } )
+      }
+
+      s.feedRune( c, raw, off, off + w )
+      off += w
+    }
+  } else {
+    buf := make( []byte, 32 * 1024 )
+    for off := 0; !s.stop; {
+      n, err := rd.Read( buf )
+      for i := 0; i < n && !s.stop; i++ {
+        s.step( buf[i], off + i, off + i + 1 )
+      }
+      off += n
+
+      if err == io.EOF { break }
+      if err != nil { return err }
+    }
+  }
+
+  s.flush()
+
+  return nil
+}
+
+type acPending struct {
+  Match
+  cstart int
+}
+
+// acScanner runs the automaton over a sequence of bytes. Positions in the
+// scanned (possibly folded) bytes map back to input offsets through orig,
+// a ring holding the input offset of the last maxLen scanned bytes.
+type acScanner struct {
+  m       *Matcher
+  state   int32
+  pos     int
+  orig    []int
+  pending []acPending
+  last    int
+  fn      func( Match ) bool
+  stop    bool
+}
+
+func (m *Matcher) newScanner( fn func( Match ) bool ) *acScanner {
+  return &acScanner{ m: m, orig: make( []int, m.maxLen + 1 ), fn: fn }
+}
+
+func (s *acScanner) feedRune( c rune, raw string, start, end int ) {
+  if c == utf8.RuneError && len( raw ) == 1 {
+    s.step( raw[0], start, end )
+    return
+  }
+
+  var k [utf8.UTFMax]byte
+  n := utf8.EncodeRune( k[:], foldRune( c ) )
+  for i := 0; i < n && !s.stop; i++ {
+    s.step( k[i], start, end )
+  }
+}
+
+// step scans b, which belongs to the input bytes [start, end)
+func (s *acScanner) step( b byte, start, end int ) {
+  m := s.m
+  s.orig[s.pos % len( s.orig )] = start
+  s.state = m.trans[int( s.state ) * m.nclass + int( m.class[b] )]
+  s.pos++
+
+  o := s.state
+  if m.out[o] < 0 { o = m.dict[o] }
+  for ; o > 0 && !s.stop; o = m.dict[o] {
+    cstart := s.pos - m.depth[o]
+    match  := Match{ m.out[o], s.orig[cstart % len( s.orig )], end }
+
+    if m.opt.Kind == Overlapping {
+      s.emit( match )
+    } else if match.Start >= s.last {
+      s.pending = append( s.pending, acPending{ match, cstart } )
+    }
+  }
+
+  if m.opt.Kind == LeftmostLongest { s.settle( false ) }
+}
+
+// settle emits the pending matches whose start no future match can precede
+func (s *acScanner) settle( all bool ) {
+  for len( s.pending ) > 0 && !s.stop {
+    first := 0
+    for i, p := range s.pending {
+      f := s.pending[first]
+      if p.cstart < f.cstart || (p.cstart == f.cstart && (p.End > f.End || (p.End == f.End && p.Pattern < f.Pattern))) {
+        first = i
+      }
+    }
+
+    best := s.pending[first]
+    if !all && s.pos < best.cstart + s.m.maxLen { return }
+
+    s.emit( best.Match )
+    s.last = best.End
+
+    k := s.pending[:0]
+    for _, p := range s.pending {
+      if p.Start >= best.End { k = append( k, p ) }
+    }
+    s.pending = k
+  }
+}
+
+func (s *acScanner) flush() {
+  if s.m.opt.Kind == LeftmostLongest { s.settle( true ) }
+}
+
+func (s *acScanner) emit( match Match ) {
+  if !s.fn( match ) { s.stop = true }
+}
+
+// foldRune maps every rune of a simple case folding orbit to the smallest
+func foldRune( c rune ) rune {
+  if c < utf8.RuneSelf {
+    if 'a' <= c && c <= 'z' { return c - 'a' + 'A' }
+    return c
+  }
+
+  min := c
+  for f := unicode.SimpleFold( c ); f != c; f = unicode.SimpleFold( f ) {
+    if f < min { min = f }
+  }
+
+  return min
+}
+
+func foldBytes( str string ) []byte {
+  k := make( []byte, 0, len( str ) )
+  for i := 0; i < len( str ); {
+    c, w := utf8.DecodeRuneInString( str[i:] )
+    if c == utf8.RuneError && w == 1 {
+      k = append( k, str[i] )
+    } else {
+      k = append( k, string( foldRune( c ) )... )
+    }
+    i += w
+  }
+
+  return k
+}
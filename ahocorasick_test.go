@@ -0,0 +1,91 @@
+package txt
+
+import (
+  "regexp"
+  "strings"
+  "testing"
+)
+
+func TestMatcherFindAll( t *testing.T ){
+  data := []struct{
+    patterns []string
+    opt      MatcherOptions
+    input    string
+    output   []Match
+  } {
+    { []string{}, MatcherOptions{}, "abc", []Match{} },
+    { []string{ "", "b" }, MatcherOptions{}, "abc", []Match{ { 1, 1, 2 } } },
+    { []string{ "he", "she", "his", "hers", "h" }, MatcherOptions{}, "ushers ahishe",
+      []Match{ { 1, 1, 4 }, { 2, 8, 11 }, { 0, 11, 13 } } },
+    { []string{ "he", "she", "his", "hers", "h" }, MatcherOptions{ Kind: Overlapping }, "ushers",
+      []Match{ { 4, 2, 3 }, { 1, 1, 4 }, { 0, 2, 4 }, { 3, 2, 6 } } },
+    { []string{ "ab", "cd", "abcde", "bc" }, MatcherOptions{}, "abcdxabcde",
+      []Match{ { 0, 0, 2 }, { 1, 2, 4 }, { 2, 5, 10 } } },
+    { []string{ "a", "a" }, MatcherOptions{}, "aa", []Match{ { 0, 0, 1 }, { 0, 1, 2 } } },
+    { []string{ "ñandú", "el" }, MatcherOptions{}, "el ÑANDÚ y el ñandú", []Match{ { 1, 0, 2 }, { 1, 13, 15 }, { 0, 16, 23 } } },
+    { []string{ "ñandú" }, MatcherOptions{ FoldCase: true }, "el ÑANDÚ", []Match{ { 0, 3, 10 } } },
+    { []string{ "straße", "k" }, MatcherOptions{ FoldCase: true }, "STRAẞE Kelvin K",
+      []Match{ { 0, 0, 8 }, { 1, 9, 10 }, { 1, 16, 19 } } },
+    { []string{ "b" }, MatcherOptions{ FoldCase: true }, "a\xffB", []Match{ { 0, 2, 3 } } },
+  }
+
+  for _, d := range data {
+    output := NewMatcher( d.patterns, d.opt ).FindAll( d.input )
+    if !cmpMatchArray( output, d.output ) {
+      t.Errorf( "NewMatcher( %q, %+v ).FindAll( %q ) \nreturn   %v\nexpected %v", d.patterns, d.opt, d.input, output, d.output )
+    }
+
+    output = output[:0]
+    err := NewMatcher( d.patterns, d.opt ).FindReader( strings.NewReader( d.input ), func( m Match ) bool {
+      output = append( output, m )
+      return true
+    })
+    if err != nil || !cmpMatchArray( output, d.output ) {
+      t.Errorf( "NewMatcher( %q, %+v ).FindReader( %q ) \nreturn   %v, %v\nexpected %v", d.patterns, d.opt, d.input, output, err, d.output )
+    }
+  }
+}
+
+func TestMatcherFindReaderStop( t *testing.T ){
+  n := 0
+  m := NewMatcher( []string{ "a" }, MatcherOptions{} )
+  m.FindReader( strings.NewReader( "aaaa" ), func( Match ) bool {
+    n++
+    return n < 2
+  })
+
+  if n != 2 {
+    t.Errorf( "FindReader( %q ) \nreturn   %d matches\nexpected 2", "aaaa", n )
+  }
+}
+
+func cmpMatchArray( a, b []Match ) bool {
+  if len( a ) != len( b ) { return false }
+
+  for i, m := range a {
+    if m != b[i] { return false }
+  }
+
+  return true
+}
+
+var acKeywords = []string{ "TODO", "FIXME", "NOTE", "DONE", "WAITING", "CANCELED", "SCHEDULED", "DEADLINE", "CLOSED", "PROPERTIES" }
+var acInput    = strings.Repeat( "* TODO revisar el ñandú, the quick brown fox jumps over the lazy dog DEADLINE: <2024-01-01>\n", 64 )
+
+func BenchmarkMatcher( b *testing.B ){
+  m := NewMatcher( acKeywords, MatcherOptions{} )
+  for i := 0; i < b.N; i++ {
+    if len( m.FindAll( acInput ) ) != 128 {
+      b.Fatal( "BenchmarkMatcher(): no match" )
+    }
+  }
+}
+
+func BenchmarkMatcherRegexp( b *testing.B ){
+  re := regexp.MustCompile( strings.Join( acKeywords, "|" ) )
+  for i := 0; i < b.N; i++ {
+    if len( re.FindAllStringIndex( acInput, -1 ) ) != 128 {
+      b.Fatal( "BenchmarkMatcherRegexp(): no match" )
+    }
+  }
+}
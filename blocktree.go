@@ -0,0 +1,96 @@
+package txt
+
+type BlankPolicy int
+
+const (
+  BlankEnds      BlankPolicy = iota // a blank line ends the block, as in DragTextByIndent
+  BlankContinues                    // blank lines followed by indented text stay inside, as in DragAllTextByIndent
+)
+
+type DedentPolicy int
+
+const (
+  DedentSibling DedentPolicy = iota // a line less indented than the previous child starts a new child
+  DedentClose                       // a line less indented than the first child closes the block
+)
+
+type TreeOptions struct {
+  Blank  BlankPolicy
+  Dedent DedentPolicy
+}
+
+// Block is a head line and the text indented under it. Start and End are
+// byte offsets of the head and body in the parsed document, trailing blank
+// lines are not part of a block
+type Block struct {
+  Head       string
+  Body       string
+  Indent     int
+  Start, End int
+  Children   []*Block
+}
+
+func ParseBlocks( str string, opt TreeOptions ) []*Block {
+  return parseBlocks( str, 0, opt )
+}
+
+func parseBlocks( str string, base int, opt TreeOptions ) []*Block {
+  var r []*Block
+
+  for i := 0; i < len( str ); {
+    line, w := GetLine( str[i:] )
+    if HasOnlySpaces( line ) {
+      i += w
+      continue
+    }
+
+    indent := CountIndentSpaces( line )
+    rest   := str[i + w:]
+
+    level := indent + 1
+    if opt.Dedent == DedentClose {
+      if n := firstIndent( rest ); n > indent { level = n }
+    }
+
+    var body string
+    var bw   int
+    if opt.Blank == BlankEnds {
+      body, bw = DragTextByIndent( rest, level )
+    } else {
+      body, _ = DragAllTextByIndent( rest, level )
+      body    = trimBlankLines( body )
+      bw      = len( body )
+    }
+
+    r = append( r, &Block{
+      Head:     line[indent:],
+      Body:     body,
+      Indent:   indent,
+      Start:    base + i,
+      End:      base + i + w + bw,
+      Children: parseBlocks( body, base + i + w, opt ),
+    } )
+
+    i += w + bw
+  }
+
+  return r
+}
+
+func firstIndent( str string ) int {
+  for init, width, line := 0, 0, ""; init < len( str ); init += width {
+    line, width = GetLine( str[init:] )
+    if !HasOnlySpaces( line ) { return CountIndentSpaces( line ) }
+  }
+
+  return 0
+}
+
+func trimBlankLines( str string ) string {
+  end, lines := len( str ), GetRawLines( str )
+  for i := len( lines ) - 1; i >= 0 && HasOnlySpaces( lines[i] ); i-- {
+    end -= len( lines[i] )
+  }
+
+  return str[:end]
+}
@@ -0,0 +1,66 @@
+package txt
+
+import (
+  "strconv"
+  "strings"
+  "testing"
+)
+
+// blockOutline writes a block tree as "head[start:end]" entries, children
+// between parentheses
+func blockOutline( blocks []*Block ) string {
+  parts := make( []string, len( blocks ) )
+  for i, b := range blocks {
+    parts[i] = b.Head + "[" + strconv.Itoa( b.Start ) + ":" + strconv.Itoa( b.End ) + "]"
+    if len( b.Children ) > 0 { parts[i] += "(" + blockOutline( b.Children ) + ")" }
+  }
+
+  return strings.Join( parts, " " )
+}
+
+func TestParseBlocks( t *testing.T ){
+  const doc = "a\n  b\n    c\n\n    d\n  e\nf\n"
+  const dedent = "a\n    b\n  c\n      d\nz"
+
+  data := []struct{
+    input    string
+    opt      TreeOptions
+    output   string
+  } {
+    { "", TreeOptions{}, "" },
+    { "\n\n", TreeOptions{}, "" },
+    { "hola", TreeOptions{}, "hola[0:4]" },
+    { "a\nb\n", TreeOptions{}, "a[0:2] b[2:4]" },
+    { doc, TreeOptions{ Blank: BlankEnds }, "a[0:12](b[2:12](c[6:12])) d[13:19] e[19:23] f[23:25]" },
+    { doc, TreeOptions{ Blank: BlankContinues }, "a[0:23](b[2:19](c[6:12] d[13:19]) e[19:23]) f[23:25]" },
+    { "a\n  b\n\n\nc", TreeOptions{ Blank: BlankContinues }, "a[0:6](b[2:6]) c[8:9]" },
+    { dedent, TreeOptions{ Dedent: DedentSibling }, "a[0:20](b[2:8] c[8:20](d[12:20])) z[20:21]" },
+    { dedent, TreeOptions{ Dedent: DedentClose }, "a[0:8](b[2:8]) c[8:20](d[12:20]) z[20:21]" },
+  }
+
+  for _, d := range data {
+    output := blockOutline( ParseBlocks( d.input, d.opt ) )
+    if output != d.output {
+      t.Errorf( "ParseBlocks( %q, %+v ) \nreturn   %s\nexpected %s", d.input, d.opt, output, d.output )
+    }
+  }
+}
+
+func TestParseBlocksBody( t *testing.T ){
+  const doc = "* title\n  text\n    more\n"
+
+  blocks := ParseBlocks( doc, TreeOptions{} )
+  if len( blocks ) != 1 {
+    t.Fatalf( "ParseBlocks( %q ) \nreturn   %d blocks\nexpected 1", doc, len( blocks ) )
+  }
+
+  b := blocks[0]
+  if b.Head != "* title" || b.Body != "  text\n    more\n" || b.Indent != 0 || doc[b.Start:b.End] != doc {
+    t.Errorf( "ParseBlocks( %q ) \nreturn   %+v", doc, b )
+  }
+
+  c := b.Children[0]
+  if c.Head != "text" || c.Body != "    more\n" || c.Indent != 2 || doc[c.Start:c.End] != "  text\n    more\n" {
+    t.Errorf( "ParseBlocks( %q ).Children[0] \nreturn   %+v", doc, c )
+  }
+}
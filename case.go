@@ -0,0 +1,124 @@
+package txt
+
+import (
+  "strings"
+  "unicode"
+  "unicode/utf8"
+)
+
+// SplitWords splits str into the words of an identifier or phrase: runs of
+// letters and digits, broken before an uppercase rune that follows a
+// lowercase one or a digit ("fooBar", "utf8Reader") and before the last
+// rune of an uppercase run followed by lowercase ("HTTPServer"). Digits
+// and combining marks stay with the word they follow.
+func SplitWords( str string ) []string {
+  r     := make( []string, 0, 8 )
+  rs    := []rune( str )
+  start := -1
+
+  for i, c := range rs {
+    if start >= 0 && isCombiningMark( c ) { continue }
+    if !isWordRune( c ) {
+      if start >= 0 { r = append( r, string( rs[start:i] ) ) }
+      start = -1
+      continue
+    }
+
+    if start >= 0 && i > start && wordBreak( rs, i ) {
+      r = append( r, string( rs[start:i] ) )
+      start = i
+    }
+    if start < 0 { start = i }
+  }
+
+  if start >= 0 { r = append( r, string( rs[start:] ) ) }
+
+  return r
+}
+
+// wordBreak looks at the runes around rs[i] skipping combining marks
+func wordBreak( rs []rune, i int ) bool {
+  c := rs[i]
+  if !unicode.IsUpper( c ) { return false }
+
+  p := i - 1
+  for p > 0 && isCombiningMark( rs[p] ) { p-- }
+  prev := rs[p]
+  if unicode.IsLower( prev ) || unicode.IsDigit( prev ) { return true }
+
+  n := i + 1
+  for n < len( rs ) && isCombiningMark( rs[n] ) { n++ }
+
+  return unicode.IsUpper( prev ) && n < len( rs ) && unicode.IsLower( rs[n] )
+}
+
+func isCombiningMark( c rune ) bool {
+  return unicode.In( c, unicode.Mn, unicode.Mc, unicode.Me )
+}
+
+// fooBarBaz
+func ToCamelCase( str string ) string {
+  w := SplitWords( str )
+  for i := range w {
+    if i == 0 {
+      w[i] = strings.ToLower( w[i] )
+    } else {
+      w[i] = titleWord( w[i] )
+    }
+  }
+
+  return strings.Join( w, "" )
+}
+
+// FooBarBaz
+func ToPascalCase( str string ) string {
+  return joinWords( str, "", titleWord )
+}
+
+// foo_bar_baz
+func ToSnakeCase( str string ) string {
+  return joinWords( str, "_", strings.ToLower )
+}
+
+// FOO_BAR_BAZ
+func ToScreamingSnakeCase( str string ) string {
+  return joinWords( str, "_", strings.ToUpper )
+}
+
+// foo-bar-baz
+func ToKebabCase( str string ) string {
+  return joinWords( str, "-", strings.ToLower )
+}
+
+// Foo Bar Baz
+func ToTitleCase( str string ) string {
+  return joinWords( str, " ", titleWord )
+}
+
+// Foo bar baz
+func ToSentenceCase( str string ) string {
+  w := SplitWords( str )
+  for i := range w {
+    if i == 0 {
+      w[i] = titleWord( w[i] )
+    } else {
+      w[i] = strings.ToLower( w[i] )
+    }
+  }
+
+  return strings.Join( w, " " )
+}
+
+func joinWords( str, sep string, fn func( string ) string ) string {
+  w := SplitWords( str )
+  for i := range w {
+    w[i] = fn( w[i] )
+  }
+
+  return strings.Join( w, sep )
+}
+
+func titleWord( word string ) string {
+  c, n := utf8.DecodeRuneInString( word )
+  return string( unicode.ToTitle( c ) ) + strings.ToLower( word[n:] )
+}
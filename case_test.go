@@ -0,0 +1,72 @@
+package txt
+
+import (
+  "testing"
+)
+
+func TestSplitWords( t *testing.T ){
+  data := []struct{
+    input    string
+    output   []string
+  } {
+    { "", []string{} },
+    { " _- ", []string{} },
+    { "Hello World", []string{ "Hello", "World" } },
+    { "fooBar", []string{ "foo", "Bar" } },
+    { "HTTPServer", []string{ "HTTP", "Server" } },
+    { "getHTTPResponseCode", []string{ "get", "HTTP", "Response", "Code" } },
+    { "utf8Reader", []string{ "utf8", "Reader" } },
+    { "HTTP2Server", []string{ "HTTP2", "Server" } },
+    { "version2", []string{ "version2" } },
+    { "2ndPlace", []string{ "2nd", "Place" } },
+    { "foo_bar-baz.qux", []string{ "foo", "bar", "baz", "qux" } },
+    { "ID", []string{ "ID" } },
+    { "añoNuevo", []string{ "año", "Nuevo" } },
+    { "ÑANDÚSalvaje", []string{ "ÑANDÚ", "Salvaje" } },
+    { "привет мир", []string{ "привет", "мир" } },
+    { "नमस्ते दुनिया", []string{ "नमस्ते", "दुनिया" } },
+    { "cafe\u0301Noir", []string{ "cafe\u0301", "Noir" } },
+    { "NA\u0303NDU\u0301Salvaje", []string{ "NA\u0303NDU\u0301", "Salvaje" } },
+  }
+
+  for _, d := range data {
+    output := SplitWords( d.input )
+    if !cmpStringArray( output, d.output ) {
+      t.Errorf( "SplitWords( %q ) \nreturn   %q\nexpected %q", d.input, output, d.output )
+    }
+  }
+}
+
+func TestCaseConversions( t *testing.T ){
+  data := []struct{
+    name     string
+    fn       func( string ) string
+    input    string
+    output   string
+  } {
+    { "ToCamelCase", ToCamelCase, "Hello World", "helloWorld" },
+    { "ToCamelCase", ToCamelCase, "HTTPServer", "httpServer" },
+    { "ToCamelCase", ToCamelCase, "user_id", "userId" },
+    { "ToPascalCase", ToPascalCase, "get-http-response", "GetHttpResponse" },
+    { "ToPascalCase", ToPascalCase, "año nuevo", "AñoNuevo" },
+    { "ToSnakeCase", ToSnakeCase, "HTTPServer", "http_server" },
+    { "ToSnakeCase", ToSnakeCase, "Hello World", "hello_world" },
+    { "ToSnakeCase", ToSnakeCase, "utf8Reader", "utf8_reader" },
+    { "ToSnakeCase", ToSnakeCase, "ÑANDÚSalvaje", "ñandú_salvaje" },
+    { "ToSnakeCase", ToSnakeCase, "cafe\u0301 noir", "cafe\u0301_noir" },
+    { "ToScreamingSnakeCase", ToScreamingSnakeCase, "maxLineWidth", "MAX_LINE_WIDTH" },
+    { "ToKebabCase", ToKebabCase, "HTTP2Server", "http2-server" },
+    { "ToKebabCase", ToKebabCase, "  Hello,  World!  ", "hello-world" },
+    { "ToTitleCase", ToTitleCase, "the_quick brownFox", "The Quick Brown Fox" },
+    { "ToTitleCase", ToTitleCase, "ǆemal", "ǅemal" },
+    { "ToSentenceCase", ToSentenceCase, "TheQuickBrownFox", "The quick brown fox" },
+    { "ToSentenceCase", ToSentenceCase, "", "" },
+  }
+
+  for _, d := range data {
+    output := d.fn( d.input )
+    if output != d.output {
+      t.Errorf( "%s( %q ) \nreturn   %q\nexpected %q", d.name, d.input, output, d.output )
+    }
+  }
+}
@@ -0,0 +1,126 @@
+package txt
+
+import (
+  "strings"
+  "unicode"
+  "unicode/utf8"
+)
+
+// CaseFolder folds text for caseless matching. With a Form it follows the
+// caseless matching of the Unicode standard: canonical for NFC and NFD,
+// which folds the NFD of the text, and compatibility for NFKC and NFKD,
+// which also folds the compatibility decomposition of the result; the
+// folded text is given in Form. Folding, normalization and the combining
+// marks seen by the caseless functions follow the tables of UnicodeVersion,
+// not unicode.Version.
+type CaseFolder struct {
+  Turkic bool // fold I to ı and İ to i as Turkish and Azeri do
+  Form   Form
+}
+
+// DefaultCaseFolder is the canonical caseless matching of the caseless
+// functions
+var DefaultCaseFolder = CaseFolder{ Form: NFD }
+
+func FoldCase( str string ) string {
+  return CaseFolder{}.Fold( str )
+}
+
+func CaselessEqual( a, b string ) bool {
+  return DefaultCaseFolder.Equal( a, b )
+}
+
+func CaselessHasPrefix( str, prefix string ) bool {
+  return DefaultCaseFolder.HasPrefix( str, prefix )
+}
+
+func CaselessContains( str, substr string ) bool {
+  return DefaultCaseFolder.Contains( str, substr )
+}
+
+func (cf CaseFolder) Fold( str string ) string {
+  switch cf.Form {
+  case 0:
+    return cf.fold( str )
+  case NFC, NFD:
+    return cf.Form.String( NFD.String( cf.fold( NFD.String( str ) ) ) )
+  }
+
+  k := NFKD.String( cf.fold( NFKD.String( cf.fold( NFD.String( str ) ) ) ) )
+  return cf.Form.String( k )
+}
+
+func (cf CaseFolder) fold( str string ) string {
+  k := make( []byte, 0, len( str ) )
+
+  for i := 0; i < len( str ); {
+    c, w := utf8.DecodeRuneInString( str[i:] )
+    i += w
+
+    switch {
+    case c < utf8.RuneSelf:
+      if cf.Turkic && c == 'I' {
+        if strings.HasPrefix( str[i:], "\u0307" ) {
+          k  = append( k, 'i' )
+          i += len( "\u0307" )
+        } else {
+          k = append( k, "\u0131"... )
+        }
+        continue
+      }
+      if 'A' <= c && c <= 'Z' { c += 'a' - 'A' }
+      k = append( k, byte( c ) )
+    case cf.Turkic && c == 'İ':
+      k = append( k, 'i' )
+    default:
+      if f, ok := caseFoldTable[c]; ok {
+        k = append( k, f... )
+      } else {
+        k = append( k, str[i - w:i]... )
+      }
+    }
+  }
+
+  return string( k )
+}
+
+func (cf CaseFolder) Equal( a, b string ) bool {
+  return cf.Fold( a ) == cf.Fold( b )
+}
+
+// HasPrefix reports whether the folded str begins with the folded prefix
+// without splitting a combining sequence, so "ñandú" does not begin with
+// "n" even in a decomposed Form
+func (cf CaseFolder) HasPrefix( str, prefix string ) bool {
+  s, p := cf.Fold( str ), cf.Fold( prefix )
+  return strings.HasPrefix( s, p ) && !startsCombining( s[len( p ):] )
+}
+
+func (cf CaseFolder) Contains( str, substr string ) bool {
+  return cf.Index( str, substr ) >= 0
+}
+
+// Index returns the byte offset in the folded str of the first occurrence
+// of the folded substr that does not split a combining sequence, or -1
+func (cf CaseFolder) Index( str, substr string ) int {
+  s, sub := cf.Fold( str ), cf.Fold( substr )
+
+  for off := 0; off <= len( s ); {
+    i := strings.Index( s[off:], sub )
+    if i < 0 { return -1 }
+
+    i += off
+    if !startsCombining( s[i + len( sub ):] ) { return i }
+
+    _, w := utf8.DecodeRuneInString( s[i:] )
+    if w == 0 { w = 1 }
+    off = i + w
+  }
+
+  return -1
+}
+
+func startsCombining( str string ) bool {
+  c, _ := utf8.DecodeRuneInString( str )
+  return str != "" && (combiningClass( c ) != 0 || unicode.Is( nonspacingMarks, c ))
+}
@@ -0,0 +1,85 @@
+package txt
+
+import (
+  "testing"
+)
+
+func TestFoldCase( t *testing.T ){
+  data := []struct{
+    input    string
+    output   string
+  } {
+    { "", "" },
+    { "Hola MUNDO", "hola mundo" },
+    { "Straße", "strasse" },
+    { "ΣΊΣΥΦΟΣ", "σίσυφοσ" },
+    { "ﬁ", "fi" },
+    { "\u0130", "i\u0307" },
+    { "ÑANDÚ", "ñandú" },
+    { "Ǆ", "ǆ" },
+    { "ПРИВЕТ", "привет" },
+  }
+
+  for _, d := range data {
+    output := FoldCase( d.input )
+    if output != d.output {
+      t.Errorf( "FoldCase( %+q ) \nreturn   %+q\nexpected %+q", d.input, output, d.output )
+    }
+  }
+}
+
+func TestCaseFolderTurkic( t *testing.T ){
+  data := []struct{
+    cf       CaseFolder
+    input    string
+    output   string
+  } {
+    { CaseFolder{ Turkic: true }, "DİYARBAKIR", "diyarbakır" },
+    { CaseFolder{ Turkic: true, Form: NFC }, "DİYARBAKIR", "diyarbakır" },
+    { CaseFolder{ Turkic: true, Form: NFC }, "DI\u0307YARBAKIR", "diyarbak\u0131r" },
+    { CaseFolder{ Form: NFC }, "\u0130", "i\u0307" },
+  }
+
+  for _, d := range data {
+    output := d.cf.Fold( d.input )
+    if output != d.output {
+      t.Errorf( "%+v.Fold( %+q ) \nreturn   %+q\nexpected %+q", d.cf, d.input, output, d.output )
+    }
+  }
+}
+
+func TestCaseless( t *testing.T ){
+  data := []struct{
+    name     string
+    fn       func( a, b string ) bool
+    a, b     string
+    output   bool
+  } {
+    { "CaselessEqual", CaselessEqual, "STRASSE", "straße", true },
+    { "CaselessEqual", CaselessEqual, "\u00d1AND\u00da", "n\u0303andu\u0301", true },
+    { "CaselessEqual", CaselessEqual, "\u00c5", "\u212b", true },
+    { "CaselessEqual", CaselessEqual, "ﬁ", "FI", true },
+    { "CaselessEqual", CaselessEqual, "①", "1", false },
+    { "CaselessEqual", CaselessEqual, "ὈΔΥΣΣΕΎΣ", "ὀδυσσεύς", true },
+    { "CaselessHasPrefix", CaselessHasPrefix, "#+OPTIONS: toc", "#+options", true },
+    { "CaselessHasPrefix", CaselessHasPrefix, "Ñandú", "n", false },
+    { "CaselessHasPrefix", CaselessHasPrefix, "Ñandú", "ñ", true },
+    { "CaselessHasPrefix", CaselessHasPrefix, "Straße", "STRASS", true },
+    { "CaselessContains", CaselessContains, "el ÑANDÚ corre", "ñandú", true },
+    { "CaselessContains", CaselessContains, "el ÑANDÚ corre", "nandu", false },
+    { "CaselessContains", CaselessContains, "Ñandú", "and", true },
+    { "CaselessContains", CaselessContains, "Ñandú", "", true },
+  }
+
+  for _, d := range data {
+    output := d.fn( d.a, d.b )
+    if output != d.output {
+      t.Errorf( "%s( %+q, %+q ) \nreturn   %v\nexpected %v", d.name, d.a, d.b, output, d.output )
+    }
+  }
+
+  compat := CaseFolder{ Form: NFKC }
+  if !compat.Equal( "ﬁ", "FI" ) || !compat.Equal( "①", "1" ) {
+    t.Errorf( "%+v.Equal() \nreturn   false\nexpected true", compat )
+  }
+}
@@ -0,0 +1,144 @@
+package txt
+
+import "strings"
+
+var CommentPrefixes = []string{ "//", "#", "--", ";" }
+
+// Fill joins the words of str with single spaces breaking lines before they
+// get wider than width, a word wider than width stands alone in its line
+func Fill( str string, width int ) string {
+  k, col := make( []byte, 0, len( str ) ), 0
+
+  for i, word := range Tokenize( str ) {
+    w := StringWidth( word )
+    if i > 0 {
+      if col + 1 + w > width {
+        k   = append( k, '\n' )
+        col = 0
+      } else {
+        k = append( k, ' ' )
+        col++
+      }
+    }
+
+    k    = append( k, word... )
+    col += w
+  }
+
+  return string( k )
+}
+
+// FillComment reflows the run of line comments at the start of str, lines
+// sharing indentation and comment prefix, to fit in width columns. Without
+// prefixes CommentPrefixes are recognized. Blank comment lines separate
+// paragraphs, list items keep their markers with the text hanging under
+// them, and more indented lines outside items are kept as they are. It
+// returns the reflowed comment and the width consumed from str, zero when
+// str does not start with a comment
+func FillComment( str string, width int, prefixes ...string ) (string, int) {
+  if len( prefixes ) == 0 { prefixes = CommentPrefixes }
+
+  first, _ := GetLine( str )
+  indent   := first[:CountIndentSpaces( first )]
+  prefix   := commentPrefix( first[len( indent ):], prefixes )
+  if prefix == "" { return "", 0 }
+
+  contents := make( []string, 0, 16 )
+  init := 0
+  for init < len( str ) {
+    line, w := GetLine( str[init:] )
+    if CountIndentSpaces( line ) != len( indent ) || !isCommentLine( line[len( indent ):], prefix ) { break }
+
+    text := line[len( indent ) + len( prefix ):]
+    if strings.HasPrefix( text, " " ) { text = text[1:] }
+    contents = append( contents, RmSpacesAtEnd( text ) )
+    init += w
+  }
+
+  lead  := indent + prefix
+  avail := width - StringWidth( ExpandTabs( indent, DefaultTabWidth ) ) - StringWidth( prefix ) - 1
+
+  k := make( []string, 0, len( contents ) )
+  for i := 0; i < len( contents ); {
+    c := contents[i]
+
+    if c == "" {
+      k = append( k, lead )
+      i++
+      continue
+    }
+
+    if m, ok := ScanListMarker( c ); ok {
+      // a bare marker has its content indent past the end of the line
+      head, text := c, ""
+      if m.ContentIndent < len( c ) { head, text = c[:m.ContentIndent], c[m.ContentIndent:] }
+
+      j := i + 1
+      for ; j < len( contents ) && contents[j] != "" && CountIndentSpaces( contents[j] ) >= m.ContentIndent; j++ {
+        if _, sub := ScanListMarker( contents[j] ); sub { break }
+        text += " " + contents[j]
+      }
+
+      lines := GetLines( Fill( text, avail - m.ContentIndent ) )
+      if len( lines ) == 0 { k = append( k, lead + " " + head ) }
+
+      hang := strings.Repeat( " ", m.ContentIndent )
+      head += hang[len( head ):]
+      for n, l := range lines {
+        if n == 0 {
+          k = append( k, lead + " " + head + l )
+        } else {
+          k = append( k, lead + " " + hang + l )
+        }
+      }
+
+      i = j
+      continue
+    }
+
+    if CountIndentSpaces( c ) > 0 {
+      k = append( k, lead + " " + c )
+      i++
+      continue
+    }
+
+    j, text := i + 1, c
+    for ; j < len( contents ) && contents[j] != "" && CountIndentSpaces( contents[j] ) == 0; j++ {
+      if _, item := ScanListMarker( contents[j] ); item { break }
+      text += " " + contents[j]
+    }
+
+    for _, l := range GetLines( Fill( text, avail ) ) {
+      k = append( k, lead + " " + l )
+    }
+
+    i = j
+  }
+
+  r := strings.Join( k, "\n" )
+  if init > 0 && str[init - 1] == '\n' { r += "\n" }
+
+  return r, init
+}
+
+// commentPrefix returns the longest of prefixes opening line, extended with
+// repetitions of its last byte, as in "///" or ";;"
+func commentPrefix( line string, prefixes []string ) string {
+  p := ""
+  for _, c := range prefixes {
+    if len( c ) > len( p ) && strings.HasPrefix( line, c ) { p = c }
+  }
+
+  if p == "" { return "" }
+
+  n := len( p )
+  for n < len( line ) && line[n] == p[len( p ) - 1] { n++ }
+
+  return line[:n]
+}
+
+func isCommentLine( line, prefix string ) bool {
+  if !strings.HasPrefix( line, prefix ) { return false }
+
+  return len( line ) == len( prefix ) || line[len( prefix )] != prefix[len( prefix ) - 1]
+}
@@ -0,0 +1,63 @@
+package txt
+
+import "testing"
+
+func TestFill( t *testing.T ){
+  data := []struct{
+    input    string
+    width    int
+    output   string
+  } {
+    { "", 10, "" },
+    { "uno", 10, "uno" },
+    { "uno dos tres", 7, "uno dos\ntres" },
+    { "  uno\n dos\t\ttres  ", 8, "uno dos\ntres" },
+    { "uno supercalifragilistico dos", 8, "uno\nsupercalifragilistico\ndos" },
+    { "私は 私は 私は", 10, "私は 私は\n私は" },
+  }
+
+  for _, d := range data {
+    output := Fill( d.input, d.width )
+    if output != d.output {
+      t.Errorf( "Fill( %q, %d ) \nreturn   %q\nexpected %q", d.input, d.width, output, d.output )
+    }
+  }
+}
+
+func TestFillComment( t *testing.T ){
+  data := []struct{
+    input    string
+    width    int
+    output   string
+    n        int
+  } {
+    { "", 20, "", 0 },
+    { "x := 1\n// no", 20, "", 0 },
+    { "// uno dos tres cuatro", 14, "// uno dos\n// tres cuatro", 22 },
+    { "  // uno\n  // dos tres\n  x := 1", 30, "  // uno dos tres\n", 23 },
+    { "// uno\n  // dos\n", 30, "// uno\n", 7 },
+    { "# uno dos\n#\n# tres cuatro\n", 10, "# uno dos\n#\n# tres\n# cuatro\n", 26 },
+    { ";; uno\n;; dos\n; tres", 20, ";; uno dos\n", 14 },
+    { "/// uno\n/// dos", 20, "/// uno dos", 15 },
+    { "-- uno\n--dos", 20, "-- uno dos", 12 },
+    { "// lista:\n// - uno dos tres\n//   cuatro\n// - cinco\n", 16,
+      "// lista:\n// - uno dos\n//   tres cuatro\n// - cinco\n", 51 },
+    { "// code:\n//     x := 1\n//     y := 2\n// done", 30,
+      "// code:\n//     x := 1\n//     y := 2\n// done", 44 },
+    { "// -\n// foo\n", 40, "// -\n// foo\n", 12 },
+    { "# 1.\n", 40, "# 1.\n", 5 },
+    { "// -\n//   uno dos tres\n", 12, "// - uno dos\n//   tres\n", 23 },
+  }
+
+  for _, d := range data {
+    output, n := FillComment( d.input, d.width )
+    if output != d.output || n != d.n {
+      t.Errorf( "FillComment( %q, %d ) \nreturn   [%d] %q\nexpected [%d] %q", d.input, d.width, n, output, d.n, d.output )
+    }
+  }
+
+  output, n := FillComment( "% uno\n% dos\n", 20, "%" )
+  if output != "% uno dos\n" || n != 12 {
+    t.Errorf( "FillComment( %q, 20, %q ) \nreturn   [%d] %q\nexpected [%d] %q", "% uno\n% dos\n", "%", n, output, 12, "% uno dos\n" )
+  }
+}
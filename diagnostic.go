@@ -0,0 +1,175 @@
+package txt
+
+import (
+  "sort"
+  "strconv"
+  "strings"
+)
+
+// Label marks the byte span [Start, End) of a document, an empty span
+// points at the rune starting in Start
+type Label struct {
+  Start, End int
+  Message    string
+}
+
+// Diagnostic renders a compiler style excerpt of a document. The first
+// label is the primary one and is underlined with carets, the others with
+// dashes. Context lines are shown around every labeled line
+type Diagnostic struct {
+  Message  string
+  Labels   []Label
+  Context  int
+  TabWidth int
+}
+
+func RenderDiagnostic( doc string, start, end int, message string ) string {
+  return Diagnostic{ Message: message, Labels: []Label{ { start, end, "" } }, Context: 1 }.Render( doc )
+}
+
+type labelSegment struct {
+  start, end int // display columns
+  primary    bool
+  message    string
+}
+
+func (d Diagnostic) Render( doc string ) string {
+  tab := d.TabWidth
+  if tab <= 0 { tab = DefaultTabWidth }
+
+  li := NewLineIndex( doc )
+  li.TabWidth = tab
+
+  segments := make( map[int][]labelSegment )
+  shown    := make( map[int]bool )
+  last     := li.Lines()
+
+  for i, l := range d.Labels {
+    start, end := clampSpan( l.Start, l.End, len( doc ) )
+    first, _ := li.Position( start, ByteColumns )
+    final, _ := li.Position( end, ByteColumns )
+    if end > start { final, _ = li.Position( end - 1, ByteColumns ) }
+    if final > last { last = final }
+
+    for n := first; n <= final; n++ {
+      a, b := li.LineStart( n ), li.LineStart( n ) + len( li.Line( n ) )
+      if start > a { a = start }
+      if end < b { b = end }
+
+      _, ca := li.Position( a, DisplayColumns )
+      _, cb := li.Position( b, DisplayColumns )
+      if cb <= ca { cb = ca + 1 }
+
+      seg := labelSegment{ start: ca - 1, end: cb - 1, primary: i == 0 }
+      if n == final { seg.message = l.Message }
+      segments[n] = append( segments[n], seg )
+    }
+
+    for n := first - d.Context; n <= final + d.Context; n++ {
+      if n >= 1 { shown[n] = true }
+    }
+  }
+
+  lines := make( []int, 0, len( shown ) )
+  for n := range shown {
+    if n <= last { lines = append( lines, n ) }
+  }
+  sort.Ints( lines )
+
+  gutter := 1
+  if len( lines ) > 0 { gutter = len( strconv.Itoa( lines[len( lines ) - 1] ) ) }
+  pad := strings.Repeat( " ", gutter )
+
+  var k strings.Builder
+  k.WriteString( d.Message )
+  k.WriteByte( '\n' )
+
+  if len( d.Labels ) > 0 {
+    start, _ := clampSpan( d.Labels[0].Start, d.Labels[0].End, len( doc ) )
+    line, col := li.Position( start, RuneColumns )
+    k.WriteString( pad + "--> " + strconv.Itoa( line ) + ":" + strconv.Itoa( col ) + "\n" )
+  }
+
+  k.WriteString( pad + " |\n" )
+
+  for i, n := range lines {
+    if i > 0 && n > lines[i - 1] + 1 { k.WriteString( "...\n" ) }
+
+    num := strconv.Itoa( n )
+    k.WriteString( strings.Repeat( " ", gutter - len( num ) ) + num + " |" )
+    if text := RmSpacesAtEnd( ExpandTabs( li.Line( n ), tab ) ); text != "" {
+      k.WriteString( " " + text )
+    }
+    k.WriteByte( '\n' )
+
+    for _, row := range underline( segments[n] ) {
+      k.WriteString( pad + " | " + row + "\n" )
+    }
+  }
+
+  return k.String()
+}
+
+func clampSpan( start, end, max int ) (int, int) {
+  if start < 0 { start = 0 }
+  if start > max { start = max }
+  if end < start { end = start }
+  if end > max { end = max }
+
+  return start, end
+}
+
+// underline draws the markers of segs in a row, with the message of the
+// rightmost segment next to them; other messages hang below their segment
+func underline( segs []labelSegment ) []string {
+  if len( segs ) == 0 { return nil }
+
+  width := 0
+  for _, s := range segs {
+    if s.end > width { width = s.end }
+  }
+
+  row := []byte( strings.Repeat( " ", width ) )
+  for _, s := range segs {
+    for c := s.start; c < s.end; c++ {
+      if s.primary || row[c] == ' ' {
+        if s.primary { row[c] = '^' } else { row[c] = '-' }
+      }
+    }
+  }
+
+  msgs := make( []labelSegment, 0, len( segs ) )
+  for _, s := range segs {
+    if s.message != "" { msgs = append( msgs, s ) }
+  }
+  sort.SliceStable( msgs, func( i, j int ) bool { return msgs[i].start < msgs[j].start } )
+
+  first := string( row )
+  if n := len( msgs ); n > 0 && msgs[n - 1].end == width {
+    first += " " + msgs[n - 1].message
+    msgs   = msgs[:n - 1]
+  }
+
+  rows := []string{ first }
+  if len( msgs ) == 0 { return rows }
+
+  rows = append( rows, hangRow( msgs ) )
+  for j := len( msgs ) - 1; j >= 0; j-- {
+    prefix := hangRow( msgs[:j] )
+    gap    := msgs[j].start - len( prefix )
+    if gap < 0 { gap = 0 }
+    rows = append( rows, prefix + strings.Repeat( " ", gap ) + msgs[j].message )
+  }
+
+  return rows
+}
+
+func hangRow( msgs []labelSegment ) string {
+  k := make( []byte, 0, 64 )
+  for _, s := range msgs {
+    for len( k ) < s.start { k = append( k, ' ' ) }
+    if len( k ) == s.start { k = append( k, '|' ) }
+  }
+
+  return string( k )
+}
@@ -0,0 +1,105 @@
+package txt
+
+import "testing"
+
+func TestRenderDiagnostic( t *testing.T ){
+  const doc = "#+title: Doc\n#+options: toc\n\tfoo bar baz\nend\n"
+
+  data := []struct{
+    start, end int
+    message    string
+    output     string
+  } {
+    { 29, 32, "unknown directive",
+`unknown directive
+ --> 3:2
+  |
+2 | #+options: toc
+3 |         foo bar baz
+  |         ^^^
+4 | end
+` },
+    { 0, 0, "empty",
+`empty
+ --> 1:1
+  |
+1 | #+title: Doc
+  | ^
+2 | #+options: toc
+` },
+    { 46, 46, "at end",
+`at end
+ --> 5:1
+  |
+4 | end
+5 |
+  | ^
+` },
+  }
+
+  for _, d := range data {
+    output := RenderDiagnostic( doc, d.start, d.end, d.message )
+    if output != d.output {
+      t.Errorf( "RenderDiagnostic( %q, %d, %d, %q ) \nreturn\n%s\nexpected\n%s", doc, d.start, d.end, d.message, output, d.output )
+    }
+  }
+}
+
+func TestDiagnosticRender( t *testing.T ){
+  data := []struct{
+    doc        string
+    diagnostic Diagnostic
+    output     string
+  } {
+    { "let 私は = x + y\n",
+      Diagnostic{ Message: "mismatched types", Labels: []Label{ { 15, 16, "expected int" }, { 4, 10, "declared here" } } },
+`mismatched types
+ --> 1:12
+  |
+1 | let 私は = x + y
+  |     ----     ^ expected int
+  |     |
+  |     declared here
+` },
+    { "a b c d\n",
+      Diagnostic{ Message: "three", Labels: []Label{ { 6, 7, "" }, { 0, 1, "one" }, { 2, 3, "two" } } },
+`three
+ --> 1:7
+  |
+1 | a b c d
+  | - -   ^
+  | | |
+  | | two
+  | one
+` },
+    { "one\ntwo\nthree\nfour\nfive\nsix\n",
+      Diagnostic{ Message: "span", Labels: []Label{ { 5, 10, "here" }, { 24, 27, "and here" } } },
+`span
+ --> 2:2
+  |
+2 | two
+  |  ^^
+3 | three
+  | ^^ here
+...
+6 | six
+  | --- and here
+` },
+    { "x\n\ty",
+      Diagnostic{ Message: "tab", Labels: []Label{ { 3, 4, "" } }, Context: 1, TabWidth: 4 },
+`tab
+ --> 2:2
+  |
+1 | x
+2 |     y
+  |     ^
+` },
+  }
+
+  for _, d := range data {
+    output := d.diagnostic.Render( d.doc )
+    if output != d.output {
+      t.Errorf( "%+v.Render( %q ) \nreturn\n%s\nexpected\n%s", d.diagnostic, d.doc, output, d.output )
+    }
+  }
+}
@@ -0,0 +1,415 @@
+package txt
+
+import (
+  "sort"
+  "strconv"
+  "strings"
+)
+
+type DiffOp int
+
+const (
+  DiffEqual DiffOp = iota
+  DiffDelete
+  DiffInsert
+)
+
+type DiffAlgorithm int
+
+const (
+  Myers DiffAlgorithm = iota
+  Patience
+  Histogram
+)
+
+// DiffEdit is a run of equal, deleted or inserted elements, [AStart, AEnd)
+// of the old sequence and [BStart, BEnd) of the new one. A deletion has an
+// empty B range placed where it happens and an insertion an empty A range
+type DiffEdit struct {
+  Op           DiffOp
+  AStart, AEnd int
+  BStart, BEnd int
+}
+
+type DiffOptions struct {
+  Algorithm        DiffAlgorithm
+  Context          int    // unchanged lines around each hunk
+  FromFile, ToFile string // names in the unified header, "a" and "b" when empty
+}
+
+var DefaultDiffOptions = DiffOptions{ Context: 3 }
+
+// DiffStrings returns the edit script turning a into b, a deletion always
+// precedes the insertion of the same change
+func DiffStrings( a, b []string, alg DiffAlgorithm ) []DiffEdit {
+  ids := make( map[string]int, len( a ) )
+  ia, ib := internStrings( a, ids ), internStrings( b, ids )
+
+  var m []diffMatch
+  switch alg {
+  case Patience:  patienceMatches( ia, ib, 0, 0, &m )
+  case Histogram: histogramMatches( ia, ib, 0, 0, &m )
+  default:        myersMatches( ia, ib, 0, 0, &m )
+  }
+
+  return matchesToEdits( m, len( a ), len( b ) )
+}
+
+// DiffLines compares the lines of a and b, as split by GetRawLines with
+// their newlines, so a final line without newline differs from the same
+// line with it
+func DiffLines( a, b string, alg DiffAlgorithm ) []DiffEdit {
+  return DiffStrings( GetRawLines( a ), GetRawLines( b ), alg )
+}
+
+func UnifiedDiff( a, b string, opt DiffOptions ) string {
+  la, lb := GetRawLines( a ), GetRawLines( b )
+  edits  := DiffStrings( la, lb, opt.Algorithm )
+
+  type lineOp struct{ op DiffOp; a, b int }
+  ops := make( []lineOp, 0, len( la ) + len( lb ) )
+  for _, e := range edits {
+    for i, j := e.AStart, e.BStart; i < e.AEnd || j < e.BEnd; {
+      ops = append( ops, lineOp{ e.Op, i, j } )
+      if e.Op != DiffInsert { i++ }
+      if e.Op != DiffDelete { j++ }
+    }
+  }
+
+  from, to := opt.FromFile, opt.ToFile
+  if from == "" { from = "a" }
+  if to == "" { to = "b" }
+
+  ctx := opt.Context
+  if ctx < 0 { ctx = 0 }
+
+  var k strings.Builder
+  for i := 0; i < len( ops ); {
+    if ops[i].op == DiffEqual {
+      i++
+      continue
+    }
+
+    end := i
+    for j := i; j < len( ops ); j++ {
+      if ops[j].op != DiffEqual {
+        end = j + 1
+      } else if j - end >= 2 * ctx {
+        break
+      }
+    }
+
+    start := i - ctx
+    if start < 0 { start = 0 }
+    if end += ctx; end > len( ops ) { end = len( ops ) }
+
+    if k.Len() == 0 { k.WriteString( "--- " + from + "\n+++ " + to + "\n" ) }
+
+    na, nb := 0, 0
+    for _, o := range ops[start:end] {
+      if o.op != DiffInsert { na++ }
+      if o.op != DiffDelete { nb++ }
+    }
+
+    k.WriteString( "@@ -" + hunkRange( ops[start].a, na ) + " +" + hunkRange( ops[start].b, nb ) + " @@\n" )
+
+    for _, o := range ops[start:end] {
+      line, mark := "", " "
+      switch o.op {
+      case DiffEqual:  line = la[o.a]
+      case DiffDelete: line, mark = la[o.a], "-"
+      case DiffInsert: line, mark = lb[o.b], "+"
+      }
+
+      k.WriteString( mark )
+      k.WriteString( line )
+      if !strings.HasSuffix( line, "\n" ) { k.WriteString( "\n\\ No newline at end of file\n" ) }
+    }
+
+    i = end
+  }
+
+  return k.String()
+}
+
+func hunkRange( start, n int ) string {
+  switch n {
+  case 0: return strconv.Itoa( start ) + ",0"
+  case 1: return strconv.Itoa( start + 1 )
+  }
+
+  return strconv.Itoa( start + 1 ) + "," + strconv.Itoa( n )
+}
+
+func internStrings( s []string, ids map[string]int ) []int {
+  r := make( []int, len( s ) )
+  for i, str := range s {
+    id, ok := ids[str]
+    if !ok {
+      id = len( ids )
+      ids[str] = id
+    }
+    r[i] = id
+  }
+
+  return r
+}
+
+type diffMatch struct{ a, b int }
+
+func matchesToEdits( m []diffMatch, na, nb int ) []DiffEdit {
+  var r []DiffEdit
+  add := func( op DiffOp, a0, a1, b0, b1 int ) {
+    if a0 == a1 && b0 == b1 { return }
+    if n := len( r ); n > 0 && r[n - 1].Op == op && r[n - 1].AEnd == a0 && r[n - 1].BEnd == b0 {
+      r[n - 1].AEnd, r[n - 1].BEnd = a1, b1
+      return
+    }
+    r = append( r, DiffEdit{ op, a0, a1, b0, b1 } )
+  }
+
+  i, j := 0, 0
+  for _, p := range append( m, diffMatch{ na, nb } ) {
+    add( DiffDelete, i, p.a, j, j )
+    add( DiffInsert, p.a, p.a, j, p.b )
+    if p.a < na { add( DiffEqual, p.a, p.a + 1, p.b, p.b + 1 ) }
+    i, j = p.a + 1, p.b + 1
+  }
+
+  return r
+}
+
+// trimCommon emits the matches of the common prefix of a and b, returning
+// the remaining middle parts and the length of the common suffix
+func trimCommon( a, b []int, aoff, boff int, out *[]diffMatch ) ([]int, []int, int, int, int) {
+  n := 0
+  for n < len( a ) && n < len( b ) && a[n] == b[n] {
+    *out = append( *out, diffMatch{ aoff + n, boff + n } )
+    n++
+  }
+  a, b, aoff, boff = a[n:], b[n:], aoff + n, boff + n
+
+  s := 0
+  for s < len( a ) && s < len( b ) && a[len( a ) - 1 - s] == b[len( b ) - 1 - s] { s++ }
+
+  return a[:len( a ) - s], b[:len( b ) - s], aoff, boff, s
+}
+
+func emitSuffix( na, nb, aoff, boff, s int, out *[]diffMatch ) {
+  for i := 0; i < s; i++ {
+    *out = append( *out, diffMatch{ aoff + na + i, boff + nb + i } )
+  }
+}
+
+func myersMatches( a, b []int, aoff, boff int, out *[]diffMatch ) {
+  a, b, aoff, boff, s := trimCommon( a, b, aoff, boff, out )
+
+  if len( a ) > 0 && len( b ) > 0 {
+    if x, y := myersSplit( a, b ); x >= 0 {
+      myersMatches( a[:x], b[:y], aoff, boff, out )
+      myersMatches( a[x:], b[y:], aoff + x, boff + y, out )
+    }
+  }
+
+  emitSuffix( len( a ), len( b ), aoff, boff, s, out )
+}
+
+// myersSplit finds the middle snake of the shortest edit script of a and b,
+// returning where to split both sequences, or -1 when they share nothing
+func myersSplit( a, b []int ) (int, int) {
+  n, m := len( a ), len( b )
+  maxD := (n + m + 1) / 2
+  off  := maxD
+  size := 2 * maxD + 2
+
+  v1, v2 := make( []int, size ), make( []int, size )
+  for i := range v1 {
+    v1[i], v2[i] = -1, -1
+  }
+  v1[off + 1], v2[off + 1] = 0, 0
+
+  delta := n - m
+  front := delta % 2 != 0
+  k1start, k1end, k2start, k2end := 0, 0, 0, 0
+
+  for d := 0; d < maxD; d++ {
+    for k1 := -d + k1start; k1 <= d - k1end; k1 += 2 {
+      k1off := off + k1
+      x1 := 0
+      if k1 == -d || (k1 != d && v1[k1off - 1] < v1[k1off + 1]) {
+        x1 = v1[k1off + 1]
+      } else {
+        x1 = v1[k1off - 1] + 1
+      }
+
+      y1 := x1 - k1
+      for x1 < n && y1 < m && a[x1] == b[y1] { x1++; y1++ }
+      v1[k1off] = x1
+
+      if x1 > n {
+        k1end += 2
+      } else if y1 > m {
+        k1start += 2
+      } else if front {
+        if k2off := off + delta - k1; k2off >= 0 && k2off < size && v2[k2off] != -1 {
+          if x1 >= n - v2[k2off] { return x1, y1 }
+        }
+      }
+    }
+
+    for k2 := -d + k2start; k2 <= d - k2end; k2 += 2 {
+      k2off := off + k2
+      x2 := 0
+      if k2 == -d || (k2 != d && v2[k2off - 1] < v2[k2off + 1]) {
+        x2 = v2[k2off + 1]
+      } else {
+        x2 = v2[k2off - 1] + 1
+      }
+
+      y2 := x2 - k2
+      for x2 < n && y2 < m && a[n - x2 - 1] == b[m - y2 - 1] { x2++; y2++ }
+      v2[k2off] = x2
+
+      if x2 > n {
+        k2end += 2
+      } else if y2 > m {
+        k2start += 2
+      } else if !front {
+        if k1off := off + delta - k2; k1off >= 0 && k1off < size && v1[k1off] != -1 {
+          x1 := v1[k1off]
+          if x1 >= n - x2 { return x1, x1 - (k1off - off) }
+        }
+      }
+    }
+  }
+
+  return -1, -1
+}
+
+// patienceMatches anchors the diff on lines appearing once in both sides,
+// taking their longest increasing run, and recurses between anchors
+func patienceMatches( a, b []int, aoff, boff int, out *[]diffMatch ) {
+  a, b, aoff, boff, s := trimCommon( a, b, aoff, boff, out )
+
+  if len( a ) > 0 && len( b ) > 0 {
+    anchors := uniqueAnchors( a, b )
+    if len( anchors ) == 0 {
+      myersMatches( a, b, aoff, boff, out )
+    } else {
+      i, j := 0, 0
+      for _, p := range anchors {
+        patienceMatches( a[i:p.a], b[j:p.b], aoff + i, boff + j, out )
+        *out = append( *out, diffMatch{ aoff + p.a, boff + p.b } )
+        i, j = p.a + 1, p.b + 1
+      }
+      patienceMatches( a[i:], b[j:], aoff + i, boff + j, out )
+    }
+  }
+
+  emitSuffix( len( a ), len( b ), aoff, boff, s, out )
+}
+
+func uniqueAnchors( a, b []int ) []diffMatch {
+  type seen struct{ na, nb, ia, ib int }
+  count := make( map[int]*seen )
+
+  for i, x := range a {
+    c := count[x]
+    if c == nil { c = &seen{}; count[x] = c }
+    c.na++
+    c.ia = i
+  }
+  for j, x := range b {
+    if c := count[x]; c != nil {
+      c.nb++
+      c.ib = j
+    }
+  }
+
+  pairs := make( []diffMatch, 0, len( count ) )
+  for _, c := range count {
+    if c.na == 1 && c.nb == 1 { pairs = append( pairs, diffMatch{ c.ia, c.ib } ) }
+  }
+  sort.Slice( pairs, func( i, j int ) bool { return pairs[i].a < pairs[j].a } )
+
+  // longest increasing subsequence of b positions by patience sorting
+  tops, prev := make( []int, 0, len( pairs ) ), make( []int, len( pairs ) )
+  for i, p := range pairs {
+    n := sort.Search( len( tops ), func( k int ) bool { return pairs[tops[k]].b > p.b } )
+    prev[i] = -1
+    if n > 0 { prev[i] = tops[n - 1] }
+    if n == len( tops ) {
+      tops = append( tops, i )
+    } else {
+      tops[n] = i
+    }
+  }
+
+  if len( tops ) == 0 { return nil }
+
+  r, k := make( []diffMatch, len( tops ) ), tops[len( tops ) - 1]
+  for i := len( r ) - 1; i >= 0; i-- {
+    r[i] = pairs[k]
+    k    = prev[k]
+  }
+
+  return r
+}
+
+const histogramMaxChain = 64
+
+// histogramMatches splits the sequences around the longest common region
+// holding the least repeated line of a, as git's histogram diff does, and
+// falls back to Myers when every common line is too frequent
+func histogramMatches( a, b []int, aoff, boff int, out *[]diffMatch ) {
+  a, b, aoff, boff, s := trimCommon( a, b, aoff, boff, out )
+
+  if len( a ) > 0 && len( b ) > 0 {
+    pos := make( map[int][]int )
+    for i, x := range a {
+      pos[x] = append( pos[x], i )
+    }
+
+    bestA, bestB, bestLen, bestCount := -1, -1, 0, histogramMaxChain + 1
+    for j := 0; j < len( b ); j++ {
+      occ := pos[b[j]]
+      if len( occ ) == 0 || len( occ ) > histogramMaxChain || len( occ ) > bestCount { continue }
+
+      for _, i := range occ {
+        lo, hi := 0, 1
+        for i - lo > 0 && j - lo > 0 && a[i - lo - 1] == b[j - lo - 1] { lo++ }
+        for i + hi < len( a ) && j + hi < len( b ) && a[i + hi] == b[j + hi] { hi++ }
+
+        count := len( occ )
+        for k := -lo; k < hi; k++ {
+          if c := len( pos[a[i + k]] ); c < count { count = c }
+        }
+
+        if count < bestCount || (count == bestCount && lo + hi > bestLen) {
+          bestA, bestB, bestLen, bestCount = i - lo, j - lo, lo + hi, count
+        }
+      }
+    }
+
+    switch {
+    case bestA < 0 && hasCommon( pos, b ):
+      myersMatches( a, b, aoff, boff, out )
+    case bestA >= 0:
+      histogramMatches( a[:bestA], b[:bestB], aoff, boff, out )
+      for k := 0; k < bestLen; k++ {
+        *out = append( *out, diffMatch{ aoff + bestA + k, boff + bestB + k } )
+      }
+      histogramMatches( a[bestA + bestLen:], b[bestB + bestLen:], aoff + bestA + bestLen, boff + bestB + bestLen, out )
+    }
+  }
+
+  emitSuffix( len( a ), len( b ), aoff, boff, s, out )
+}
+
+func hasCommon( pos map[int][]int, b []int ) bool {
+  for _, x := range b {
+    if len( pos[x] ) > 0 { return true }
+  }
+
+  return false
+}
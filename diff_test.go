@@ -0,0 +1,162 @@
+package txt
+
+import (
+  "math/rand"
+  "testing"
+)
+
+func TestDiffStrings( t *testing.T ){
+  data := []struct{
+    a, b     string
+    output   []DiffEdit
+  } {
+    { "", "", nil },
+    { "abc", "abc", []DiffEdit{ { DiffEqual, 0, 3, 0, 3 } } },
+    { "", "ab", []DiffEdit{ { DiffInsert, 0, 0, 0, 2 } } },
+    { "ab", "", []DiffEdit{ { DiffDelete, 0, 2, 0, 0 } } },
+    { "abc", "axc", []DiffEdit{ { DiffEqual, 0, 1, 0, 1 }, { DiffDelete, 1, 2, 1, 1 }, { DiffInsert, 2, 2, 1, 2 }, { DiffEqual, 2, 3, 2, 3 } } },
+    { "abcd", "bd", []DiffEdit{ { DiffDelete, 0, 1, 0, 0 }, { DiffEqual, 1, 2, 0, 1 }, { DiffDelete, 2, 3, 1, 1 }, { DiffEqual, 3, 4, 1, 2 } } },
+  }
+
+  for _, alg := range []DiffAlgorithm{ Myers, Patience, Histogram } {
+    for _, d := range data {
+      output := DiffStrings( chars( d.a ), chars( d.b ), alg )
+      if !cmpDiffEdits( output, d.output ) {
+        t.Errorf( "DiffStrings( %q, %q, %d ) \nreturn   %v\nexpected %v", d.a, d.b, alg, output, d.output )
+      }
+    }
+  }
+}
+
+func TestDiffStringsRandom( t *testing.T ){
+  rnd := rand.New( rand.NewSource( 1 ) )
+  random := func() []string {
+    s := make( []string, rnd.Intn( 30 ) )
+    for i := range s {
+      s[i] = string( rune( 'a' + rnd.Intn( 4 ) ) )
+    }
+    return s
+  }
+
+  for n := 0; n < 500; n++ {
+    a, b := random(), random()
+    for _, alg := range []DiffAlgorithm{ Myers, Patience, Histogram } {
+      edits := DiffStrings( a, b, alg )
+      equal, ok := applyDiffEdits( a, b, edits )
+      if !ok {
+        t.Fatalf( "DiffStrings( %q, %q, %d ) \nreturn   invalid script %v", a, b, alg, edits )
+      }
+      if alg == Myers && equal != lcsLength( a, b ) {
+        t.Fatalf( "DiffStrings( %q, %q, Myers ) \nreturn   %d equal\nexpected %d", a, b, equal, lcsLength( a, b ) )
+      }
+    }
+  }
+}
+
+func TestDiffPatience( t *testing.T ){
+  a := []string{ "{", "a", "}", "", "{", "b", "}" }
+  b := []string{ "{", "b", "}", "", "{", "a", "}", "", "{", "b", "}" }
+
+  output := DiffStrings( a, b, Patience )
+  expected := []DiffEdit{ { DiffEqual, 0, 1, 0, 1 }, { DiffInsert, 1, 1, 1, 5 }, { DiffEqual, 1, 7, 5, 11 } }
+  if !cmpDiffEdits( output, expected ) {
+    t.Errorf( "DiffStrings( %q, %q, Patience ) \nreturn   %v\nexpected %v", a, b, output, expected )
+  }
+}
+
+func TestUnifiedDiff( t *testing.T ){
+  data := []struct{
+    a, b     string
+    context  int
+    output   string
+  } {
+    { "", "", 3, "" },
+    { "a\nb\n", "a\nb\n", 3, "" },
+    { "a\nb\nc\n", "a\nx\nc\n", 3, "--- a\n+++ b\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n" },
+    { "a\nb\nc\n", "a\nx\nc\n", 0, "--- a\n+++ b\n@@ -2 +2 @@\n-b\n+x\n" },
+    { "", "a\n", 3, "--- a\n+++ b\n@@ -0,0 +1 @@\n+a\n" },
+    { "a\nb\n", "a\n", 3, "--- a\n+++ b\n@@ -1,2 +1 @@\n a\n-b\n" },
+    { "a\nb", "a\nb\n", 3, "--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n" },
+    { "a\n", "a\nb", 3, "--- a\n+++ b\n@@ -1 +1,2 @@\n a\n+b\n\\ No newline at end of file\n" },
+    { "1\n2\n3\n4\n5\n6\n7\n8\n9\n", "1\nx\n3\n4\n5\n6\n7\ny\n9\n", 1,
+      "--- a\n+++ b\n@@ -1,3 +1,3 @@\n 1\n-2\n+x\n 3\n@@ -7,3 +7,3 @@\n 7\n-8\n+y\n 9\n" },
+    { "1\n2\n3\n4\n5\n", "1\nx\n3\n4\ny\n", 1,
+      "--- a\n+++ b\n@@ -1,5 +1,5 @@\n 1\n-2\n+x\n 3\n 4\n-5\n+y\n" },
+  }
+
+  for _, d := range data {
+    opt := DiffOptions{ Context: d.context }
+    output := UnifiedDiff( d.a, d.b, opt )
+    if output != d.output {
+      t.Errorf( "UnifiedDiff( %q, %q, %+v ) \nreturn\n%s\nexpected\n%s", d.a, d.b, opt, output, d.output )
+    }
+  }
+
+  output := UnifiedDiff( "a\n", "b\n", DiffOptions{ FromFile: "old.txt", ToFile: "new.txt" } )
+  if output != "--- old.txt\n+++ new.txt\n@@ -1 +1 @@\n-a\n+b\n" {
+    t.Errorf( "UnifiedDiff() with file names \nreturn\n%s", output )
+  }
+}
+
+func chars( str string ) []string {
+  r := make( []string, 0, len( str ) )
+  for _, c := range str {
+    r = append( r, string( c ) )
+  }
+
+  return r
+}
+
+func cmpDiffEdits( a, b []DiffEdit ) bool {
+  if len( a ) != len( b ) { return false }
+
+  for i, e := range a {
+    if e != b[i] { return false }
+  }
+
+  return true
+}
+
+// applyDiffEdits checks that edits cover a and b in order, with equal runs
+// holding equal elements, and returns how many elements are kept
+func applyDiffEdits( a, b []string, edits []DiffEdit ) (int, bool) {
+  i, j, equal := 0, 0, 0
+  for _, e := range edits {
+    if e.AStart != i || e.BStart != j { return 0, false }
+
+    switch e.Op {
+    case DiffEqual:
+      if e.AEnd - e.AStart != e.BEnd - e.BStart { return 0, false }
+      for k := 0; k < e.AEnd - e.AStart; k++ {
+        if a[e.AStart + k] != b[e.BStart + k] { return 0, false }
+      }
+      equal += e.AEnd - e.AStart
+    case DiffDelete:
+      if e.BStart != e.BEnd { return 0, false }
+    case DiffInsert:
+      if e.AStart != e.AEnd { return 0, false }
+    }
+
+    i, j = e.AEnd, e.BEnd
+  }
+
+  return equal, i == len( a ) && j == len( b )
+}
+
+func lcsLength( a, b []string ) int {
+  dp := make( []int, len( b ) + 1 )
+  for i := 1; i <= len( a ); i++ {
+    prev := 0
+    for j := 1; j <= len( b ); j++ {
+      tmp := dp[j]
+      if a[i - 1] == b[j - 1] {
+        dp[j] = prev + 1
+      } else if dp[j - 1] > dp[j] {
+        dp[j] = dp[j - 1]
+      }
+      prev = tmp
+    }
+  }
+
+  return dp[len( b )]
+}
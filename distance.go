@@ -0,0 +1,184 @@
+package txt
+
+type Units int
+
+const (
+  RuneUnits     Units = iota
+  GraphemeUnits       // as split by Graphemes
+)
+
+// split returns the units of a and b as ints, runes by value and multi-rune
+// grapheme clusters by negative ids shared between both strings
+func (u Units) split( a, b string ) ([]int, []int) {
+  if u == GraphemeUnits {
+    ids := make( map[string]int )
+    return graphemeIds( a, ids ), graphemeIds( b, ids )
+  }
+
+  return runeIds( a ), runeIds( b )
+}
+
+func runeIds( str string ) []int {
+  r := make( []int, 0, len( str ) )
+  for _, c := range str {
+    r = append( r, int( c ) )
+  }
+
+  return r
+}
+
+func graphemeIds( str string, ids map[string]int ) []int {
+  g := Graphemes( str )
+  r := make( []int, len( g ) )
+  for i, s := range g {
+    if c := []rune( s ); len( c ) == 1 {
+      r[i] = int( c[0] )
+      continue
+    }
+
+    id, ok := ids[s]
+    if !ok {
+      id = -len( ids ) - 1
+      ids[s] = id
+    }
+    r[i] = id
+  }
+
+  return r
+}
+
+func Levenshtein( a, b string, u Units ) int {
+  x, y := u.split( a, b )
+  d, _ := editDistance( x, y, -1, false )
+  return d
+}
+
+// BoundedLevenshtein stops as soon as the distance exceeds max, reporting
+// false in that case
+func BoundedLevenshtein( a, b string, u Units, max int ) (int, bool) {
+  x, y := u.split( a, b )
+  return editDistance( x, y, max, false )
+}
+
+// DamerauLevenshtein is the optimal string alignment distance: Levenshtein
+// with transpositions of adjacent units, no unit edited more than once
+func DamerauLevenshtein( a, b string, u Units ) int {
+  x, y := u.split( a, b )
+  d, _ := editDistance( x, y, -1, true )
+  return d
+}
+
+func BoundedDamerauLevenshtein( a, b string, u Units, max int ) (int, bool) {
+  x, y := u.split( a, b )
+  return editDistance( x, y, max, true )
+}
+
+func editDistance( a, b []int, max int, transpose bool ) (int, bool) {
+  if len( a ) < len( b ) { a, b = b, a }
+  if max >= 0 && len( a ) - len( b ) > max { return max + 1, false }
+  if len( b ) == 0 { return len( a ), true }
+
+  prev2 := make( []int, len( b ) + 1 )
+  prev  := make( []int, len( b ) + 1 )
+  cur   := make( []int, len( b ) + 1 )
+  for j := range prev {
+    prev[j] = j
+  }
+
+  for i := 1; i <= len( a ); i++ {
+    cur[0] = i
+    low   := i
+
+    for j := 1; j <= len( b ); j++ {
+      cost := 1
+      if a[i - 1] == b[j - 1] { cost = 0 }
+
+      d := prev[j - 1] + cost
+      if v := prev[j] + 1; v < d { d = v }
+      if v := cur[j - 1] + 1; v < d { d = v }
+      if transpose && i > 1 && j > 1 && a[i - 1] == b[j - 2] && a[i - 2] == b[j - 1] {
+        if v := prev2[j - 2] + 1; v < d { d = v }
+      }
+
+      cur[j] = d
+      if d < low { low = d }
+    }
+
+    if max >= 0 && low > max { return max + 1, false }
+
+    prev2, prev, cur = prev, cur, prev2
+  }
+
+  d := prev[len( b )]
+  if max >= 0 && d > max { return max + 1, false }
+
+  return d, true
+}
+
+// JaroWinkler returns the Jaro similarity of a and b raised for a common
+// prefix of up to four units, from 0 for nothing in common to 1 for equal
+func JaroWinkler( a, b string, u Units ) float64 {
+  x, y := u.split( a, b )
+  if len( x ) == 0 && len( y ) == 0 { return 1 }
+  if len( x ) == 0 || len( y ) == 0 { return 0 }
+
+  window := len( x )
+  if len( y ) > window { window = len( y ) }
+  window = window / 2 - 1
+  if window < 0 { window = 0 }
+
+  mx, my := make( []bool, len( x ) ), make( []bool, len( y ) )
+  m := 0
+  for i := range x {
+    lo, hi := i - window, i + window + 1
+    if lo < 0 { lo = 0 }
+    if hi > len( y ) { hi = len( y ) }
+
+    for j := lo; j < hi; j++ {
+      if !my[j] && x[i] == y[j] {
+        mx[i], my[j] = true, true
+        m++
+        break
+      }
+    }
+  }
+
+  if m == 0 { return 0 }
+
+  t, j := 0, 0
+  for i := range x {
+    if !mx[i] { continue }
+    for !my[j] { j++ }
+    if x[i] != y[j] { t++ }
+    j++
+  }
+
+  fm  := float64( m )
+  sim := (fm / float64( len( x ) ) + fm / float64( len( y ) ) + (fm - float64( t ) / 2) / fm) / 3
+
+  l := 0
+  for l < 4 && l < len( x ) && l < len( y ) && x[l] == y[l] { l++ }
+
+  return sim + float64( l ) * 0.1 * (1 - sim)
+}
+
+// LCSSimilarity is twice the length of the longest common subsequence of a
+// and b over their total length, 1 for two empty strings
+func LCSSimilarity( a, b string, u Units ) float64 {
+  x, y := u.split( a, b )
+  if len( x ) + len( y ) == 0 { return 1 }
+
+  prev, cur := make( []int, len( y ) + 1 ), make( []int, len( y ) + 1 )
+  for i := 1; i <= len( x ); i++ {
+    for j := 1; j <= len( y ); j++ {
+      switch {
+      case x[i - 1] == y[j - 1]: cur[j] = prev[j - 1] + 1
+      case prev[j] > cur[j - 1]: cur[j] = prev[j]
+      default:                   cur[j] = cur[j - 1]
+      }
+    }
+    prev, cur = cur, prev
+  }
+
+  return 2 * float64( prev[len( y )] ) / float64( len( x ) + len( y ) )
+}
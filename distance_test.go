@@ -0,0 +1,124 @@
+package txt
+
+import (
+  "math"
+  "testing"
+)
+
+func TestLevenshtein( t *testing.T ){
+  data := []struct{
+    a, b     string
+    units    Units
+    output   int
+  } {
+    { "", "", RuneUnits, 0 },
+    { "", "abc", RuneUnits, 3 },
+    { "kitten", "sitting", RuneUnits, 3 },
+    { "flaw", "lawn", RuneUnits, 2 },
+    { "ca", "ac", RuneUnits, 2 },
+    { "ñandú", "nandu", RuneUnits, 2 },
+    { "#+options", "#+optoins", RuneUnits, 2 },
+    { "éa", "ea", RuneUnits, 1 },
+    { "éa", "ea", GraphemeUnits, 1 },
+    { "éa", "èa", GraphemeUnits, 1 },
+    { "🇲🇽🇪🇸", "🇪🇸", GraphemeUnits, 1 },
+    { "🇲🇽🇪🇸", "🇪🇸", RuneUnits, 2 },
+  }
+
+  for _, d := range data {
+    output := Levenshtein( d.a, d.b, d.units )
+    if output != d.output {
+      t.Errorf( "Levenshtein( %q, %q, %d ) \nreturn   %d\nexpected %d", d.a, d.b, d.units, output, d.output )
+    }
+  }
+}
+
+func TestDamerauLevenshtein( t *testing.T ){
+  data := []struct{
+    a, b     string
+    output   int
+  } {
+    { "", "", 0 },
+    { "ca", "ac", 1 },
+    { "ca", "abc", 3 },
+    { "#+options", "#+optoins", 1 },
+    { "kitten", "sitting", 3 },
+    { "tiúlo", "títlo", 2 },
+  }
+
+  for _, d := range data {
+    output := DamerauLevenshtein( d.a, d.b, RuneUnits )
+    if output != d.output {
+      t.Errorf( "DamerauLevenshtein( %q, %q ) \nreturn   %d\nexpected %d", d.a, d.b, output, d.output )
+    }
+  }
+}
+
+func TestBoundedLevenshtein( t *testing.T ){
+  data := []struct{
+    a, b     string
+    max      int
+    output   int
+    ok       bool
+  } {
+    { "kitten", "sitting", 3, 3, true },
+    { "kitten", "sitting", 2, 3, false },
+    { "kitten", "sitting", 0, 1, false },
+    { "a", "abcdef", 2, 3, false },
+    { "", "", 0, 0, true },
+    { "title", "title", 0, 0, true },
+  }
+
+  for _, d := range data {
+    output, ok := BoundedLevenshtein( d.a, d.b, RuneUnits, d.max )
+    if output != d.output || ok != d.ok {
+      t.Errorf( "BoundedLevenshtein( %q, %q, %d ) \nreturn   %d, %v\nexpected %d, %v", d.a, d.b, d.max, output, ok, d.output, d.ok )
+    }
+  }
+
+  if output, ok := BoundedDamerauLevenshtein( "ca", "ac", RuneUnits, 1 ); output != 1 || !ok {
+    t.Errorf( "BoundedDamerauLevenshtein( %q, %q, 1 ) \nreturn   %d, %v\nexpected 1, true", "ca", "ac", output, ok )
+  }
+}
+
+func TestJaroWinkler( t *testing.T ){
+  data := []struct{
+    a, b     string
+    output   float64
+  } {
+    { "", "", 1 },
+    { "abc", "", 0 },
+    { "abc", "xyz", 0 },
+    { "martha", "marhta", 0.9611 },
+    { "dwayne", "duane", 0.84 },
+    { "dixon", "dicksonx", 0.8133 },
+    { "título", "titulo", 0.9 },
+  }
+
+  for _, d := range data {
+    output := JaroWinkler( d.a, d.b, RuneUnits )
+    if math.Abs( output - d.output ) > 0.0001 {
+      t.Errorf( "JaroWinkler( %q, %q ) \nreturn   %.4f\nexpected %.4f", d.a, d.b, output, d.output )
+    }
+  }
+}
+
+func TestLCSSimilarity( t *testing.T ){
+  data := []struct{
+    a, b     string
+    output   float64
+  } {
+    { "", "", 1 },
+    { "abc", "", 0 },
+    { "abc", "abc", 1 },
+    { "abcd", "acbd", 0.75 },
+    { "ñandú", "nandu", 0.6 },
+  }
+
+  for _, d := range data {
+    output := LCSSimilarity( d.a, d.b, RuneUnits )
+    if math.Abs( output - d.output ) > 0.0001 {
+      t.Errorf( "LCSSimilarity( %q, %q ) \nreturn   %.4f\nexpected %.4f", d.a, d.b, output, d.output )
+    }
+  }
+}
@@ -0,0 +1,84 @@
+package txt
+
+import "strings"
+
+// FencedBlock is a block delimited by fence lines: ``` or ~~~ runs of three
+// or more, or org style #+begin_name and #+end_name. Marker is the opening
+// fence as written, Info the text following it and Body the lines between
+// the fences without the opening fence indentation. Unlike CommonMark both
+// kinds of fences open and close at any indentation, as fences inside list
+// items do. An unterminated block runs to the end of the text
+type FencedBlock struct {
+  Marker     string
+  Info       string
+  Body       string
+  Indent     int
+  Terminated bool
+}
+
+// DragFencedBlock extracts the fenced block opened by the first line of str,
+// it returns a zero width when that line is not an opening fence
+func DragFencedBlock( str string ) (FencedBlock, int) {
+  line, width := GetLine( str )
+
+  b, isClose := openFence( line )
+  if isClose == nil { return FencedBlock{}, 0 }
+
+  k := make( []byte, 0, len( str ) )
+  for init := width; init < len( str ); {
+    line, w := GetLine( str[init:] )
+    if isClose( line ) {
+      b.Body, b.Terminated = string( k ), true
+      return b, init + w
+    }
+
+    n := CountIndentSpaces( line )
+    if n > b.Indent { n = b.Indent }
+    k = append( k, str[init + n:init + w]... )
+    init += w
+  }
+
+  b.Body = string( k )
+  return b, len( str )
+}
+
+func openFence( line string ) (FencedBlock, func( string ) bool) {
+  indent := CountIndentSpaces( line )
+  rest   := line[indent:]
+
+  if len( rest ) > 8 && strings.EqualFold( rest[:8], "#+begin_" ) {
+    name := rest[8:CountInitChars( rest )]
+    if name == "" { return FencedBlock{}, nil }
+
+    end := "#+end_" + name
+    b   := FencedBlock{ Marker: rest[:8 + len( name )], Info: RmSpacesToTheSides( rest[8 + len( name ):] ), Indent: indent }
+    return b, func( l string ) bool {
+      l = RmSpacesToTheSides( l )
+      return len( l ) == len( end ) && strings.EqualFold( l, end )
+    }
+  }
+
+  if rest == "" || (rest[0] != '`' && rest[0] != '~') { return FencedBlock{}, nil }
+
+  n := fenceRun( rest )
+  if n < 3 { return FencedBlock{}, nil }
+
+  info := RmSpacesToTheSides( rest[n:] )
+  if rest[0] == '`' && strings.IndexByte( info, '`' ) >= 0 { return FencedBlock{}, nil }
+
+  b := FencedBlock{ Marker: rest[:n], Info: info, Indent: indent }
+  return b, func( l string ) bool {
+    i := CountIndentSpaces( l )
+    if i == len( l ) || l[i] != rest[0] { return false }
+
+    m := fenceRun( l[i:] )
+    return m >= n && HasOnlySpaces( l[i + m:] )
+  }
+}
+
+func fenceRun( str string ) int {
+  n := 0
+  for n < len( str ) && str[n] == str[0] { n++ }
+
+  return n
+}
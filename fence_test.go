@@ -0,0 +1,38 @@
+package txt
+
+import "testing"
+
+func TestDragFencedBlock( t *testing.T ){
+  data := []struct{
+    input    string
+    output   FencedBlock
+    n        int
+  } {
+    { "", FencedBlock{}, 0 },
+    { "text\n```\n", FencedBlock{}, 0 },
+    { "``\ncode\n``", FencedBlock{}, 0 },
+    { "    ```\ncode\n```", FencedBlock{ "```", "", "code\n", 4, true }, 16 },
+    { "    ```\n    code\n    ```\n", FencedBlock{ "```", "", "code\n", 4, true }, 25 },
+    { "      ~~~ sh\n        ls\n      ~~~\n", FencedBlock{ "~~~", "sh", "  ls\n", 6, true }, 34 },
+    { "``` go `x`\ncode\n```", FencedBlock{}, 0 },
+    { "```\n```", FencedBlock{ "```", "", "", 0, true }, 7 },
+    { "```go\nfmt.Println()\n```\nafter", FencedBlock{ "```", "go", "fmt.Println()\n", 0, true }, 24 },
+    { "~~~~ sh  \n~~~\n```\n~~~~~\nafter", FencedBlock{ "~~~~", "sh", "~~~\n```\n", 0, true }, 24 },
+    { "  ```\n  a\n b\nc\n    d\n ```\n", FencedBlock{ "```", "", "a\nb\nc\n  d\n", 2, true }, 26 },
+    { "```\n``` x\n````  \n", FencedBlock{ "```", "", "``` x\n", 0, true }, 17 },
+    { "```\ncode\n", FencedBlock{ "```", "", "code\n", 0, false }, 9 },
+    { "#+begin_src python :results output\nprint( 1 )\n#+end_src\nafter",
+      FencedBlock{ "#+begin_src", "python :results output", "print( 1 )\n", 0, true }, 56 },
+    { "  #+BEGIN_EXAMPLE\n    text\n  #+END_EXAMPLE\n",
+      FencedBlock{ "#+BEGIN_EXAMPLE", "", "  text\n", 2, true }, 43 },
+    { "#+begin_quote\n#+end_src\ntext", FencedBlock{ "#+begin_quote", "", "#+end_src\ntext", 0, false }, 28 },
+    { "#+begin_ x\n#+end_", FencedBlock{}, 0 },
+  }
+
+  for _, d := range data {
+    output, n := DragFencedBlock( d.input )
+    if output != d.output || n != d.n {
+      t.Errorf( "DragFencedBlock( %q ) \nreturn   [%d] %+v\nexpected [%d] %+v", d.input, n, output, d.n, d.output )
+    }
+  }
+}
@@ -0,0 +1,103 @@
+package txt
+
+import "strings"
+
+// EncodeFlowed writes str as format=flowed text (RFC 3676, DelSp=no). Every
+// line of str is a paragraph, optionally quoted with "> " prefixes as read
+// by CountStrictPrefixDepth. Paragraphs are broken after spaces into lines
+// no wider than width when possible, a trailing space marking each soft
+// break, and lines starting with a space, ">" or "From " are space-stuffed
+func EncodeFlowed( str string, width int ) string {
+  k := make( []byte, 0, len( str ) + len( str ) / 16 )
+
+  for _, raw := range GetRawLines( str ) {
+    line := strings.TrimRight( raw, "\r\n" )
+
+    depth, n := CountStrictPrefixDepth( line, "> " )
+    quote    := strings.Repeat( ">", depth )
+    content  := line[n:]
+
+    if content != "-- " { content = strings.TrimRight( content, " " ) }
+
+    limit := width - depth - 1
+    for i, chunk := range flowedChunks( content, limit ) {
+      if i > 0 { k = append( k, '\n' ) }
+      k = append( k, quote... )
+      if depth > 0 || needsStuffing( chunk ) { k = append( k, ' ' ) }
+      k = append( k, chunk... )
+    }
+
+    if len( line ) < len( raw ) { k = append( k, '\n' ) }
+  }
+
+  return string( k )
+}
+
+func needsStuffing( line string ) bool {
+  return strings.HasPrefix( line, " " ) || strings.HasPrefix( line, ">" ) || strings.HasPrefix( line, "From " )
+}
+
+// flowedChunks cuts str after spaces followed by a non space, keeping every
+// chunk within limit columns unless a single word is wider
+func flowedChunks( str string, limit int ) []string {
+  r := make( []string, 0, 4 )
+
+  for StringWidth( str ) > limit {
+    cut, col := 0, 0
+    for i, c := range str {
+      if i > 0 && c != ' ' && str[i - 1] == ' ' {
+        if col > limit {
+          if cut == 0 { cut = i }
+          break
+        }
+        cut = i
+      }
+      col += RuneWidth( c )
+    }
+
+    if cut == 0 { break }
+
+    r   = append( r, str[:cut] )
+    str = str[cut:]
+  }
+
+  return append( r, str )
+}
+
+// DecodeFlowed joins the soft broken lines of format=flowed text into one
+// line per paragraph. Quoted paragraphs keep one '>' per level followed by
+// a space, as ">> text". With delsp the space before each soft break is
+// removed, as DelSp=yes asks
+func DecodeFlowed( str string, delsp bool ) string {
+  k := make( []byte, 0, len( str ) )
+
+  open, depth := false, 0
+  for _, raw := range GetRawLines( str ) {
+    line := strings.TrimRight( raw, "\r\n" )
+
+    d := 0
+    for d < len( line ) && line[d] == '>' { d++ }
+    content := line[d:]
+    if strings.HasPrefix( content, " " ) { content = content[1:] }
+
+    if open && d != depth { k = append( k, '\n' ) }
+
+    if !open || d != depth {
+      k = append( k, strings.Repeat( ">", d )... )
+      if d > 0 && content != "" { k = append( k, ' ' ) }
+    }
+
+    flowed := strings.HasSuffix( content, " " ) && content != "-- "
+    if flowed && delsp { content = content[:len( content ) - 1] }
+
+    k     = append( k, content... )
+    open  = flowed
+    depth = d
+
+    if !flowed && len( line ) < len( raw ) { k = append( k, '\n' ) }
+  }
+
+  if open && strings.HasSuffix( str, "\n" ) { k = append( k, '\n' ) }
+
+  return string( k )
+}
@@ -0,0 +1,71 @@
+package txt
+
+import "testing"
+
+func TestEncodeFlowed( t *testing.T ){
+  data := []struct{
+    input    string
+    width    int
+    output   string
+  } {
+    { "", 20, "" },
+    { "hola", 20, "hola" },
+    { "hola\n", 20, "hola\n" },
+    { "uno dos tres cuatro\n", 10, "uno dos \ntres \ncuatro\n" },
+    { "uno dos   \n\ntres\n", 10, "uno dos\n\ntres\n" },
+    { "supercalifragilistico uno\n", 10, "supercalifragilistico \nuno\n" },
+    { "> uno dos tres\n>> cuatro\n", 10, "> uno dos \n> tres\n>> cuatro\n" },
+    { " indent\n>not quote\nFrom me\n", 20, "  indent\n >not quote\n From me\n" },
+    { "-- \nfirma\n", 20, "-- \nfirma\n" },
+    { "私は 私は 私は\n", 12, "私は 私は \n私は\n" },
+  }
+
+  for _, d := range data {
+    output := EncodeFlowed( d.input, d.width )
+    if output != d.output {
+      t.Errorf( "EncodeFlowed( %q, %d ) \nreturn   %q\nexpected %q", d.input, d.width, output, d.output )
+    }
+  }
+}
+
+func TestDecodeFlowed( t *testing.T ){
+  data := []struct{
+    input    string
+    delsp    bool
+    output   string
+  } {
+    { "", false, "" },
+    { "hola", false, "hola" },
+    { "uno dos \ntres \ncuatro\n", false, "uno dos tres cuatro\n" },
+    { "uno dos \ntres \n", false, "uno dos tres \n" },
+    { "uno\r\ndos \r\ntres\r\n", false, "uno\ndos tres\n" },
+    { "uno \ndos\n", true, "unodos\n" },
+    { "> uno \n> dos\n>> tres \n>>cuatro\n>\n", false, "> uno dos\n>> tres cuatro\n>\n" },
+    { "> uno \n>> dos\n", false, "> uno \n>> dos\n" },
+    { "  indent\n >not quote\n From me\n", false, " indent\n>not quote\nFrom me\n" },
+    { "-- \nfirma\n", false, "-- \nfirma\n" },
+  }
+
+  for _, d := range data {
+    output := DecodeFlowed( d.input, d.delsp )
+    if output != d.output {
+      t.Errorf( "DecodeFlowed( %q, %v ) \nreturn   %q\nexpected %q", d.input, d.delsp, output, d.output )
+    }
+  }
+}
+
+func TestFlowedRoundTrip( t *testing.T ){
+  data := []string{
+    "",
+    "uno dos tres cuatro cinco seis siete ocho nueve diez\n",
+    "> quoted text that is long enough to be wrapped\n>> deeper\nplain  with  double  spaces\n",
+    " stuffed line that is long enough to be wrapped\nFrom here\n-- \nfirma",
+  }
+
+  for _, d := range data {
+    output := DecodeFlowed( EncodeFlowed( d, 20 ), false )
+    if output != d {
+      t.Errorf( "DecodeFlowed( EncodeFlowed( %q ) ) \nreturn   %q\nexpected %q", d, output, d )
+    }
+  }
+}
@@ -0,0 +1,200 @@
+package txt
+
+import (
+  "sort"
+  "strings"
+  "unicode"
+)
+
+// scores follow fzf: a match is worth scoreMatch plus the bonus of its
+// position, gaps between matches are penalized and the first pattern rune
+// doubles its bonus
+const (
+  fuzzyScoreMatch       = 16
+  fuzzyGapStart         = -3
+  fuzzyGapExtension     = -1
+  fuzzyBonusBoundary    = fuzzyScoreMatch / 2
+  fuzzyBonusWhite       = fuzzyBonusBoundary + 2
+  fuzzyBonusDelimiter   = fuzzyBonusBoundary + 1
+  fuzzyBonusNonWord     = fuzzyScoreMatch / 2
+  fuzzyBonusCamel       = fuzzyBonusBoundary + fuzzyGapExtension
+  fuzzyBonusConsecutive = -(fuzzyGapStart + fuzzyGapExtension)
+  fuzzyFirstMultiplier  = 2
+)
+
+type FuzzyResult struct {
+  Index     int    // index of the candidate
+  Text      string
+  Score     int
+  Positions []int  // rune indices of the matched runes in Text
+}
+
+// FuzzyMatch reports whether the runes of pattern appear in order in
+// candidate, returning the best score of such an alignment and the rune
+// indices it matched. The match ignores case unless pattern has uppercase
+// runes.
+func FuzzyMatch( pattern, candidate string ) (int, []int, bool) {
+  var f fuzzyScorer
+  f.compile( pattern )
+  return f.match( candidate )
+}
+
+// FuzzyFind returns the candidates matching pattern ordered by descending
+// score, shorter candidates and then earlier ones first on ties
+func FuzzyFind( pattern string, candidates []string ) []FuzzyResult {
+  var f fuzzyScorer
+  f.compile( pattern )
+
+  r := make( []FuzzyResult, 0, 16 )
+  for i, c := range candidates {
+    if score, pos, ok := f.match( c ); ok {
+      r = append( r, FuzzyResult{ Index: i, Text: c, Score: score, Positions: pos } )
+    }
+  }
+
+  sort.SliceStable( r, func( i, j int ) bool {
+    if r[i].Score != r[j].Score { return r[i].Score > r[j].Score }
+    return len( r[i].Text ) < len( r[j].Text )
+  })
+
+  return r
+}
+
+// fuzzyScorer keeps the compiled pattern and the matrices reused between
+// candidates
+type fuzzyScorer struct {
+  pattern []rune
+  fold    bool
+
+  text    []rune
+  bonus   []int
+  score   []int // score[i*n + j], pattern rune i matched at text rune j
+  run     []int // bonus of the consecutive run ending at the cell
+  from    []int // previous match of the cell, -1 for none
+}
+
+func (f *fuzzyScorer) compile( pattern string ) {
+  f.pattern = []rune( pattern )
+  f.fold    = true
+  for _, c := range f.pattern {
+    if unicode.IsUpper( c ) { f.fold = false }
+  }
+}
+
+const fuzzyNone = -1 << 30
+
+func (f *fuzzyScorer) match( candidate string ) (int, []int, bool) {
+  m := len( f.pattern )
+  if m == 0 { return 0, []int{}, true }
+
+  f.text  = f.text[:0]
+  f.bonus = f.bonus[:0]
+  prev   := ' '
+  for _, c := range candidate {
+    f.bonus = append( f.bonus, fuzzyBonus( prev, c ) )
+    prev = c
+    if f.fold { c = unicode.ToLower( c ) }
+    f.text = append( f.text, c )
+  }
+
+  if !f.isSubsequence() { return 0, nil, false }
+
+  n := len( f.text )
+  if cap( f.score ) < m * n {
+    f.score = make( []int, m * n )
+    f.run   = make( []int, m * n )
+    f.from  = make( []int, m * n )
+  }
+  score, run, from := f.score[:m * n], f.run[:m * n], f.from[:m * n]
+
+  for i := 0; i < m; i++ {
+    // gap, at, the best previous match reaching j across at least one
+    // unmatched rune, with its gap penalties applied
+    gap, at := fuzzyNone, -1
+
+    for j := 0; j < n; j++ {
+      k := i * n + j
+      score[k], from[k] = fuzzyNone, -1
+
+      if i > 0 && j > 1 {
+        p := (i - 1) * n + j - 2
+        gap += fuzzyGapExtension
+        if score[p] != fuzzyNone && score[p] + fuzzyGapStart > gap {
+          gap, at = score[p] + fuzzyGapStart, j - 2
+        }
+      }
+
+      if f.text[j] != f.pattern[i] { continue }
+
+      if i == 0 {
+        score[k] = fuzzyScoreMatch + f.bonus[j] * fuzzyFirstMultiplier
+        run[k]   = f.bonus[j]
+        continue
+      }
+
+      if gap > fuzzyNone / 2 {
+        score[k], run[k], from[k] = gap + fuzzyScoreMatch + f.bonus[j], f.bonus[j], at
+      }
+
+      if j > 0 && score[k - n - 1] != fuzzyNone {
+        rb := run[k - n - 1]
+        if f.bonus[j] >= fuzzyBonusBoundary && f.bonus[j] > rb { rb = f.bonus[j] }
+        b := rb
+        if b < fuzzyBonusConsecutive { b = fuzzyBonusConsecutive }
+        if b < f.bonus[j] { b = f.bonus[j] }
+
+        if s := score[k - n - 1] + fuzzyScoreMatch + b; s >= score[k] {
+          score[k], run[k], from[k] = s, rb, j - 1
+        }
+      }
+    }
+  }
+
+  best, end := fuzzyNone, -1
+  for j := 0; j < n; j++ {
+    if s := score[(m - 1) * n + j]; s > best { best, end = s, j }
+  }
+
+  pos := make( []int, m )
+  for i := m - 1; i >= 0; i-- {
+    pos[i] = end
+    end    = from[i * n + end]
+  }
+
+  return best, pos, true
+}
+
+func (f *fuzzyScorer) isSubsequence() bool {
+  i := 0
+  for _, c := range f.text {
+    if i < len( f.pattern ) && c == f.pattern[i] { i++ }
+  }
+
+  return i == len( f.pattern )
+}
+
+// fuzzyBonus is the bonus of matching c when it follows prev
+func fuzzyBonus( prev, c rune ) int {
+  if !isWordRune( c ) {
+    if unicode.IsSpace( c ) { return fuzzyBonusWhite }
+    return fuzzyBonusNonWord
+  }
+
+  switch {
+  case unicode.IsSpace( prev ):
+    return fuzzyBonusWhite
+  case strings.ContainsRune( "/,:;|", prev ):
+    return fuzzyBonusDelimiter
+  case !isWordRune( prev ):
+    return fuzzyBonusBoundary
+  case unicode.IsLower( prev ) && unicode.IsUpper( c ),
+       !unicode.IsDigit( prev ) && unicode.IsDigit( c ):
+    return fuzzyBonusCamel
+  }
+
+  return 0
+}
+
+func isWordRune( c rune ) bool {
+  return unicode.IsLetter( c ) || unicode.IsDigit( c )
+}
@@ -0,0 +1,79 @@
+package txt
+
+import (
+  "testing"
+)
+
+func TestFuzzyMatch( t *testing.T ){
+  data := []struct{
+    pattern, candidate string
+    positions          []int
+    ok                 bool
+  } {
+    { "", "abc", []int{}, true },
+    { "abc", "abc", []int{ 0, 1, 2 }, true },
+    { "abc", "ab", nil, false },
+    { "fb", "foo bar", []int{ 0, 4 }, true },
+    { "fb", "fooBar", []int{ 0, 3 }, true },
+    { "hs", "HTTPServer", []int{ 0, 4 }, true },
+    { "Hs", "hs", nil, false },
+    { "HS", "HTTPServer", []int{ 0, 4 }, true },
+    { "mdl", "model_list", []int{ 0, 2, 6 }, true },
+    { "oo", "foo/oo", []int{ 4, 5 }, true },
+    { "tl", "título largo", []int{ 0, 7 }, true },
+    { "ñd", "el ñandú", []int{ 3, 6 }, true },
+  }
+
+  for _, d := range data {
+    _, positions, ok := FuzzyMatch( d.pattern, d.candidate )
+    if ok != d.ok || !cmpIntArray( positions, d.positions ) {
+      t.Errorf( "FuzzyMatch( %q, %q ) \nreturn   %v, %v\nexpected %v, %v", d.pattern, d.candidate, positions, ok, d.positions, d.ok )
+    }
+  }
+}
+
+func TestFuzzyMatchRanking( t *testing.T ){
+  // each pattern scores higher against better than against worse
+  data := []struct{
+    pattern, better, worse string
+  } {
+    { "fb", "fooBar", "foobar" },
+    { "fb", "foo bar", "foobar" },
+    { "abc", "abc", "a_b_c" },
+    { "abc", "a_b_c", "axxbxxc" },
+    { "doc", "my doc", "xdxoxc" },
+  }
+
+  for _, d := range data {
+    b, _, _ := FuzzyMatch( d.pattern, d.better )
+    w, _, _ := FuzzyMatch( d.pattern, d.worse )
+    if b <= w {
+      t.Errorf( "FuzzyMatch( %q, ... ) \nreturn   %q: %d, %q: %d\nexpected first higher", d.pattern, d.better, b, d.worse, w )
+    }
+  }
+}
+
+func TestFuzzyFind( t *testing.T ){
+  candidates := []string{ "xdxoxc", "Documents", "my doc", "odc", "docs" }
+  expected   := []int{ 4, 2, 1, 0 }
+
+  r := FuzzyFind( "doc", candidates )
+  output := make( []int, len( r ) )
+  for i, m := range r {
+    output[i] = m.Index
+  }
+
+  if !cmpIntArray( output, expected ) {
+    t.Errorf( "FuzzyFind( %q, %q ) \nreturn   %v\nexpected %v", "doc", candidates, output, expected )
+  }
+}
+
+func cmpIntArray( a, b []int ) bool {
+  if len( a ) != len( b ) { return false }
+
+  for i, n := range a {
+    if n != b[i] { return false }
+  }
+
+  return true
+}
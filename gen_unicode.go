@@ -0,0 +1,368 @@
+//go:build ignore
+// +build ignore
+
+// gen_unicode writes the normalization tables of normtables.go and the
+// case folding tables of foldtable.go from the files of the Unicode
+// character database, fetched from unicode.org or read from a local
+// directory:
+//
+//   go run gen_unicode.go -version 14.0.0
+//   go run gen_unicode.go -version 14.0.0 -ucd path/to/ucd
+package main
+
+import (
+  "bufio"
+  "bytes"
+  "flag"
+  "fmt"
+  "io"
+  "log"
+  "net/http"
+  "os"
+  "path/filepath"
+  "strconv"
+  "strings"
+)
+
+var (
+  version = flag.String( "version", "14.0.0", "version of the Unicode character database" )
+  ucd     = flag.String( "ucd", "", "URL or directory of the database, unicode.org when empty" )
+)
+
+const (
+  hangulSBase  = 0xAC00
+  hangulLBase  = 0x1100
+  hangulVBase  = 0x1161
+  hangulTBase  = 0x11A7
+  hangulTCount = 28
+  hangulNCount = 21 * hangulTCount
+  hangulSCount = 19 * hangulNCount
+)
+
+type char struct {
+  cat    string // general category
+  ccc    int
+  tagged bool   // compatibility decomposition
+  decomp []rune
+}
+
+var chars = make( map[rune]*char )
+
+func main() {
+  flag.Parse()
+  if *ucd == "" { *ucd = "https://www.unicode.org/Public/" + *version + "/ucd" }
+
+  parseUnicodeData()
+  excluded := parseExclusions()
+
+  writeFile( "normtables.go", normTables( excluded ) )
+  writeFile( "foldtable.go", foldTables( parseCaseFolding() ) )
+}
+
+func open( name string ) io.ReadCloser {
+  if strings.HasPrefix( *ucd, "http://" ) || strings.HasPrefix( *ucd, "https://" ) {
+    res, err := http.Get( *ucd + "/" + name )
+    if err != nil { log.Fatal( err ) }
+    if res.StatusCode != http.StatusOK { log.Fatalf( "%s: %s", name, res.Status ) }
+    return res.Body
+  }
+
+  f, err := os.Open( filepath.Join( *ucd, name ) )
+  if err != nil { log.Fatal( err ) }
+
+  return f
+}
+
+// eachLine calls fn with the ';' separated fields of every line of the
+// database file name, comments removed
+func eachLine( name string, fn func( fields []string ) ) {
+  f := open( name )
+  defer f.Close()
+
+  s := bufio.NewScanner( f )
+  for s.Scan() {
+    line := s.Text()
+    if i := strings.IndexByte( line, '#' ); i >= 0 { line = line[:i] }
+    if strings.TrimSpace( line ) == "" { continue }
+
+    fields := strings.Split( line, ";" )
+    for i := range fields {
+      fields[i] = strings.TrimSpace( fields[i] )
+    }
+    fn( fields )
+  }
+
+  if err := s.Err(); err != nil { log.Fatal( err ) }
+}
+
+func parseRune( str string ) rune {
+  n, err := strconv.ParseUint( str, 16, 32 )
+  if err != nil { log.Fatal( err ) }
+
+  return rune( n )
+}
+
+func parseRunes( str string ) []rune {
+  r := make( []rune, 0, 4 )
+  for _, f := range strings.Fields( str ) {
+    r = append( r, parseRune( f ) )
+  }
+
+  return r
+}
+
+// parseUnicodeData reads the combining class and decomposition of every
+// rune, the ranges of UnicodeData.txt have neither
+func parseUnicodeData() {
+  eachLine( "UnicodeData.txt", func( f []string ) {
+    ccc, err := strconv.Atoi( f[3] )
+    if err != nil { log.Fatal( err ) }
+
+    c := &char{ cat: f[2], ccc: ccc }
+    if d := f[5]; d != "" {
+      if strings.HasPrefix( d, "<" ) {
+        c.tagged = true
+        d = d[strings.IndexByte( d, '>' ) + 1:]
+      }
+      c.decomp = parseRunes( d )
+    }
+
+    chars[parseRune( f[0] )] = c
+  })
+}
+
+func parseExclusions() map[rune]bool {
+  r := make( map[rune]bool )
+  eachLine( "CompositionExclusions.txt", func( f []string ) {
+    r[parseRune( f[0] )] = true
+  })
+
+  return r
+}
+
+// parseCaseFolding reads the full case folding, statuses C and F; the
+// Turkic mappings, status T, are handled by CaseFolder
+func parseCaseFolding() map[rune][]rune {
+  r := make( map[rune][]rune )
+  eachLine( "CaseFolding.txt", func( f []string ) {
+    if f[1] == "C" || f[1] == "F" { r[parseRune( f[0] )] = parseRunes( f[2] ) }
+  })
+
+  return r
+}
+
+func combiningClass( c rune ) int {
+  if ch, ok := chars[c]; ok { return ch.ccc }
+  return 0
+}
+
+func isHangulSyllable( c rune ) bool {
+  return hangulSBase <= c && c < hangulSBase + hangulSCount
+}
+
+// decompose returns the full canonical, or with compat compatibility,
+// decomposition of c in canonical order
+func decompose( c rune, compat bool ) []rune {
+  r := decomposeRec( nil, c, compat )
+
+  for i := 1; i < len( r ); i++ {
+    ccc := combiningClass( r[i] )
+    if ccc == 0 { continue }
+    for j := i; j > 0; j-- {
+      p := combiningClass( r[j - 1] )
+      if p == 0 || p <= ccc { break }
+      r[j], r[j - 1] = r[j - 1], r[j]
+    }
+  }
+
+  return r
+}
+
+func decomposeRec( r []rune, c rune, compat bool ) []rune {
+  if isHangulSyllable( c ) {
+    s := c - hangulSBase
+    r  = append( r, hangulLBase + s / hangulNCount, hangulVBase + (s % hangulNCount) / hangulTCount )
+    if t := s % hangulTCount; t != 0 { r = append( r, hangulTBase + t ) }
+    return r
+  }
+
+  ch, ok := chars[c]
+  if !ok || ch.decomp == nil || (ch.tagged && !compat) { return append( r, c ) }
+
+  for _, d := range ch.decomp {
+    r = decomposeRec( r, d, compat )
+  }
+
+  return r
+}
+
+func sortedRunes() []rune {
+  r := make( []rune, 0, len( chars ) )
+  for c := rune( 0 ); c <= 0x10FFFF; c++ {
+    if _, ok := chars[c]; ok { r = append( r, c ) }
+  }
+
+  return r
+}
+
+func escape( rs []rune ) string {
+  var k strings.Builder
+  k.WriteByte( '"' )
+  for _, c := range rs {
+    if c < 0x10000 {
+      fmt.Fprintf( &k, "\\u%04X", c )
+    } else {
+      fmt.Fprintf( &k, "\\U%08X", c )
+    }
+  }
+  k.WriteByte( '"' )
+
+  return k.String()
+}
+
+// table writes the entries of a composite literal, wrapped at 100 columns
+func table( k *bytes.Buffer, entries []string ) {
+  tableIndent( k, entries, "  ", "}\n" )
+}
+
+func tableIndent( k *bytes.Buffer, entries []string, indent, end string ) {
+  line := indent
+  for _, e := range entries {
+    if len( line ) + len( e ) > 100 {
+      k.WriteString( strings.TrimRight( line, " " ) + "\n" )
+      line = indent
+    }
+    line += e
+  }
+  k.WriteString( strings.TrimRight( line, " " ) + "\n" + end )
+}
+
+func header( k *bytes.Buffer ) {
+  fmt.Fprintf( k, "// Code generated by gen_unicode.go from the Unicode %s character database. DO NOT EDIT.\n\npackage txt\n", *version )
+}
+
+func normTables( excluded map[rune]bool ) []byte {
+  var canon, compat, compose, ccc []string
+
+  var lo, hi rune
+  cc := 0
+  flush := func() {
+    if cc != 0 { ccc = append( ccc, fmt.Sprintf( "{ 0x%X, 0x%X, %d }, ", lo, hi, cc ) ) }
+  }
+
+  for _, c := range sortedRunes() {
+    if isHangulSyllable( c ) { continue }
+
+    d, kd := decompose( c, false ), decompose( c, true )
+    if len( d ) != 1 || d[0] != c {
+      canon = append( canon, fmt.Sprintf( "0x%X: %s, ", c, escape( d ) ) )
+    }
+    if string( kd ) != string( d ) {
+      compat = append( compat, fmt.Sprintf( "0x%X: %s, ", c, escape( kd ) ) )
+    }
+
+    // primary composites: canonical pairs of starters not excluded
+    ch := chars[c]
+    if !ch.tagged && len( ch.decomp ) == 2 && !excluded[c] && ch.ccc == 0 && combiningClass( ch.decomp[0] ) == 0 {
+      compose = append( compose, fmt.Sprintf( "{ 0x%X, 0x%X }: 0x%X, ", ch.decomp[0], ch.decomp[1], c ) )
+    }
+
+    if ch.ccc != 0 && ch.ccc == cc && c == hi + 1 {
+      hi = c
+    } else if ch.ccc != 0 {
+      flush()
+      lo, hi, cc = c, c, ch.ccc
+    }
+  }
+  flush()
+
+  var k bytes.Buffer
+  header( &k )
+  fmt.Fprintf( &k, "\n// UnicodeVersion is the version of the Unicode character database that\n" +
+                   "// normalization and case folding follow, which may differ from the\n" +
+                   "// unicode.Version of the standard library\n" +
+                   "const UnicodeVersion = %q\n", *version )
+
+  k.WriteString( "\n// full canonical decompositions, Hangul syllables excluded\nvar canonDecomp = map[rune]string{\n" )
+  table( &k, canon )
+  k.WriteString( "\n// full compatibility decompositions differing from the canonical one\nvar compatDecomp = map[rune]string{\n" )
+  table( &k, compat )
+  k.WriteString( "\n// primary composites by the pair they decompose into\nvar composeTable = map[[2]rune]rune{\n" )
+  table( &k, compose )
+  k.WriteString( "\n// canonical combining classes other than zero\nvar cccTable = []cccRange{\n" )
+  table( &k, ccc )
+
+  return k.Bytes()
+}
+
+func foldTables( folds map[rune][]rune ) []byte {
+  var fold, marks []string
+
+  for c := rune( 0x80 ); c <= 0x10FFFF; c++ {
+    if f, ok := folds[c]; ok { fold = append( fold, fmt.Sprintf( "0x%X: %s, ", c, quote( f ) ) ) }
+  }
+
+  var lo, hi rune = -1, -1
+  for _, c := range sortedRunes() {
+    if chars[c].cat != "Mn" { continue }
+    if c == hi + 1 {
+      hi = c
+      continue
+    }
+    if lo >= 0 { marks = append( marks, markRange( lo, hi ) ) }
+    lo, hi = c, c
+  }
+  if lo >= 0 { marks = append( marks, markRange( lo, hi ) ) }
+
+  var r16, r32 []string
+  for _, m := range marks {
+    if strings.HasPrefix( m, "R32" ) {
+      r32 = append( r32, m[3:] )
+    } else {
+      r16 = append( r16, m[3:] )
+    }
+  }
+
+  var k bytes.Buffer
+  header( &k )
+  k.WriteString( "\nimport \"unicode\"\n" )
+  k.WriteString( "\n// full case folding of the runes above ASCII that fold to something else\nvar caseFoldTable = map[rune]string{\n" )
+  table( &k, fold )
+  k.WriteString( "\n// nonspacing marks, general category Mn\nvar nonspacingMarks = &unicode.RangeTable{\n  R16: []unicode.Range16{\n" )
+  tableIndent( &k, r16, "    ", "  },\n" )
+  k.WriteString( "  R32: []unicode.Range32{\n" )
+  tableIndent( &k, r32, "    ", "  },\n}\n" )
+
+  return k.Bytes()
+}
+
+// markRange formats the range [lo, hi] prefixed by the table it goes to
+func markRange( lo, hi rune ) string {
+  if hi <= 0xFFFF { return fmt.Sprintf( "R16{ 0x%04X, 0x%04X, 1 }, ", lo, hi ) }
+  return fmt.Sprintf( "R32{ 0x%05X, 0x%05X, 1 }, ", lo, hi )
+}
+
+// quote writes printable ASCII as is and escapes any other rune
+func quote( rs []rune ) string {
+  var k strings.Builder
+  k.WriteByte( '"' )
+  for _, c := range rs {
+    switch {
+    case c == '"' || c == '\\':
+      k.WriteString( "\\" + string( c ) )
+    case 0x20 <= c && c < 0x7F:
+      k.WriteRune( c )
+    case c < 0x10000:
+      fmt.Fprintf( &k, "\\u%04X", c )
+    default:
+      fmt.Fprintf( &k, "\\U%08X", c )
+    }
+  }
+  k.WriteByte( '"' )
+
+  return k.String()
+}
+
+func writeFile( name string, data []byte ) {
+  if err := os.WriteFile( name, data, 0644 ); err != nil { log.Fatal( err ) }
+}
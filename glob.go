@@ -0,0 +1,324 @@
+package txt
+
+import (
+  "errors"
+  "strconv"
+  "unicode"
+  "unicode/utf8"
+)
+
+var (
+  ErrUnterminatedClass = errors.New( "txt: unterminated character class" )
+  ErrUnterminatedBrace = errors.New( "txt: unterminated brace" )
+)
+
+type GlobError struct {
+  Offset int
+  Err    error
+}
+
+func (e *GlobError) Error() string {
+  return e.Err.Error() + " at offset " + strconv.Itoa( e.Offset )
+}
+
+func (e *GlobError) Unwrap() error { return e.Err }
+
+// GlobOptions sets how a glob pattern matches. With a Separator, '*', '?'
+// and classes do not match it while '**' does, and '**' between separators
+// also matches no segment at all, so "a/**/b" matches "a/b".
+type GlobOptions struct {
+  FoldCase  bool
+  Separator rune
+}
+
+// Glob is a compiled wildcard pattern: '*' matches any run of runes, '?'
+// one rune, "[a-z]" and "[!a-z]" (or "[^a-z]") a rune in or out of a set,
+// "{a,b}" any of the comma separated alternatives, which may nest, and a
+// backslash quotes the next rune
+type Glob struct {
+  opt  GlobOptions
+  prog []globInst
+}
+
+type globOp int
+
+const (
+  globMatch globOp = iota
+  globRune
+  globAny
+  globClass
+  globStar
+  globGlobstar
+  globSplit
+)
+
+type globInst struct {
+  op     globOp
+  r      rune
+  ranges []rune // lo, hi pairs
+  neg    bool
+  next   []int
+}
+
+type globNode struct {
+  op     globOp
+  r      rune
+  ranges []rune
+  neg    bool
+  alts   [][]globNode // globSplit
+}
+
+func CompileGlob( pattern string, opt GlobOptions ) (*Glob, error) {
+  p := globParser{ str: pattern, opt: opt }
+  nodes, err := p.parse( false )
+  if err != nil { return nil, err }
+
+  g := &Glob{ opt: opt, prog: []globInst{ { op: globMatch } } }
+  g.compile( nodes, 0 )
+
+  return g, nil
+}
+
+// MatchGlob reports whether str matches pattern without case folding or a
+// separator
+func MatchGlob( pattern, str string ) (bool, error) {
+  g, err := CompileGlob( pattern, GlobOptions{} )
+  if err != nil { return false, err }
+
+  return g.Match( str ), nil
+}
+
+// compile appends the program of nodes continuing at next and returns its
+// entry; the program is built from the end so every branch knows its next
+func (g *Glob) compile( nodes []globNode, next int ) int {
+  for i := len( nodes ) - 1; i >= 0; i-- {
+    n   := nodes[i]
+    ins := globInst{ op: n.op, r: n.r, ranges: n.ranges, neg: n.neg, next: []int{ next } }
+
+    if n.op == globSplit {
+      ins.next = make( []int, len( n.alts ) )
+      for j, alt := range n.alts {
+        ins.next[j] = g.compile( alt, next )
+      }
+    }
+
+    g.prog = append( g.prog, ins )
+    next   = len( g.prog ) - 1
+  }
+
+  return next
+}
+
+func (g *Glob) Match( str string ) bool {
+  start := len( g.prog ) - 1
+  cur   := globSet{ mark: make( []int, len( g.prog ) ), gen: 1 }
+  nxt   := globSet{ mark: make( []int, len( g.prog ) ), gen: 1 }
+  g.add( &cur, start )
+
+  for _, c := range str {
+    if len( cur.pcs ) == 0 { return false }
+
+    nxt.reset()
+    for _, pc := range cur.pcs {
+      ins := &g.prog[pc]
+      switch ins.op {
+      case globRune:
+        if g.equal( ins.r, c ) { g.add( &nxt, ins.next[0] ) }
+      case globAny:
+        if c != g.opt.Separator || g.opt.Separator == 0 { g.add( &nxt, ins.next[0] ) }
+      case globClass:
+        if (c != g.opt.Separator || g.opt.Separator == 0) && g.inClass( ins, c ) { g.add( &nxt, ins.next[0] ) }
+      case globStar:
+        if c != g.opt.Separator || g.opt.Separator == 0 { g.add( &nxt, pc ) }
+      case globGlobstar:
+        g.add( &nxt, pc )
+      }
+    }
+
+    cur, nxt = nxt, cur
+  }
+
+  return cur.mark[0] == cur.gen
+}
+
+type globSet struct {
+  pcs  []int
+  mark []int
+  gen  int
+}
+
+func (s *globSet) reset() {
+  s.pcs = s.pcs[:0]
+  s.gen++
+}
+
+// add puts pc in s following the transitions that consume nothing
+func (g *Glob) add( s *globSet, pc int ) {
+  if s.mark[pc] == s.gen { return }
+  s.mark[pc] = s.gen
+
+  ins := &g.prog[pc]
+  switch ins.op {
+  case globSplit:
+    for _, n := range ins.next {
+      g.add( s, n )
+    }
+    return
+  case globStar, globGlobstar:
+    g.add( s, ins.next[0] )
+  }
+
+  s.pcs = append( s.pcs, pc )
+}
+
+func (g *Glob) equal( r, c rune ) bool {
+  if g.opt.FoldCase { return foldRune( r ) == foldRune( c ) }
+  return r == c
+}
+
+func (g *Glob) inClass( ins *globInst, c rune ) bool {
+  in := globInRanges( ins.ranges, c )
+  if !in && g.opt.FoldCase {
+    for f := unicode.SimpleFold( c ); f != c && !in; f = unicode.SimpleFold( f ) {
+      in = globInRanges( ins.ranges, f )
+    }
+  }
+
+  return in != ins.neg
+}
+
+func globInRanges( ranges []rune, c rune ) bool {
+  for i := 0; i < len( ranges ); i += 2 {
+    if ranges[i] <= c && c <= ranges[i + 1] { return true }
+  }
+
+  return false
+}
+
+type globParser struct {
+  str string
+  pos int
+  opt GlobOptions
+}
+
+func (p *globParser) next() rune {
+  c, w := utf8.DecodeRuneInString( p.str[p.pos:] )
+  p.pos += w
+  return c
+}
+
+// parse reads nodes up to the end of the pattern or, inside braces, up to
+// an unquoted ',' or '}', which is left unread
+func (p *globParser) parse( inBrace bool ) ([]globNode, error) {
+  nodes := make( []globNode, 0, 8 )
+
+  for p.pos < len( p.str ) {
+    start := p.pos
+    c     := p.next()
+
+    switch c {
+    case ',', '}':
+      if inBrace {
+        p.pos = start
+        return nodes, nil
+      }
+      nodes = append( nodes, globNode{ op: globRune, r: c } )
+    case '\\':
+      if p.pos == len( p.str ) { return nil, &GlobError{ start, ErrUnterminatedEscape } }
+      nodes = append( nodes, globNode{ op: globRune, r: p.next() } )
+    case '?':
+      nodes = append( nodes, globNode{ op: globAny } )
+    case '*':
+      if p.pos == len( p.str ) || p.str[p.pos] != '*' {
+        nodes = append( nodes, globNode{ op: globStar } )
+        break
+      }
+
+      p.pos++
+      for p.pos < len( p.str ) && p.str[p.pos] == '*' { p.pos++ }
+
+      sep := p.opt.Separator
+      atSegment := start == 0 || (sep != 0 && lastRune( p.str[:start] ) == sep)
+      if sep != 0 && atSegment && p.pos < len( p.str ) && firstRune( p.str[p.pos:] ) == sep {
+        p.next()
+        nodes = append( nodes, globNode{ op: globSplit, alts: [][]globNode{
+          {},
+          { { op: globGlobstar }, { op: globRune, r: sep } },
+        } } )
+        break
+      }
+      nodes = append( nodes, globNode{ op: globGlobstar } )
+    case '[':
+      n, err := p.parseClass( start )
+      if err != nil { return nil, err }
+      nodes = append( nodes, n )
+    case '{':
+      n, err := p.parseBrace( start )
+      if err != nil { return nil, err }
+      nodes = append( nodes, n )
+    default:
+      nodes = append( nodes, globNode{ op: globRune, r: c } )
+    }
+  }
+
+  if inBrace { return nil, &GlobError{ len( p.str ), ErrUnterminatedBrace } }
+
+  return nodes, nil
+}
+
+func (p *globParser) parseBrace( start int ) (globNode, error) {
+  n := globNode{ op: globSplit }
+
+  for {
+    alt, err := p.parse( true )
+    if err != nil {
+      if errors.Is( err, ErrUnterminatedBrace ) { err = &GlobError{ start, ErrUnterminatedBrace } }
+      return n, err
+    }
+
+    n.alts = append( n.alts, alt )
+    if p.next() == '}' { return n, nil }
+  }
+}
+
+func (p *globParser) parseClass( start int ) (globNode, error) {
+  n := globNode{ op: globClass }
+
+  if p.pos < len( p.str ) && (p.str[p.pos] == '!' || p.str[p.pos] == '^') {
+    n.neg = true
+    p.pos++
+  }
+
+  for first := true; ; first = false {
+    if p.pos >= len( p.str ) { return n, &GlobError{ start, ErrUnterminatedClass } }
+
+    lo := p.next()
+    if lo == ']' && !first { return n, nil }
+    if lo == '\\' {
+      if p.pos >= len( p.str ) { return n, &GlobError{ start, ErrUnterminatedClass } }
+      lo = p.next()
+    }
+
+    hi := lo
+    if p.pos + 1 < len( p.str ) && p.str[p.pos] == '-' && p.str[p.pos + 1] != ']' {
+      p.pos++
+      hi = p.next()
+      if hi == '\\' {
+        if p.pos >= len( p.str ) { return n, &GlobError{ start, ErrUnterminatedClass } }
+        hi = p.next()
+      }
+    }
+
+    n.ranges = append( n.ranges, lo, hi )
+  }
+}
+
+func firstRune( str string ) rune {
+  c, _ := utf8.DecodeRuneInString( str )
+  return c
+}
+
+func lastRune( str string ) rune {
+  c, _ := utf8.DecodeLastRuneInString( str )
+  return c
+}
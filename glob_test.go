@@ -0,0 +1,93 @@
+package txt
+
+import (
+  "errors"
+  "testing"
+)
+
+func TestGlobMatch( t *testing.T ){
+  slash := GlobOptions{ Separator: '/' }
+  fold  := GlobOptions{ FoldCase: true }
+
+  data := []struct{
+    pattern  string
+    opt      GlobOptions
+    input    string
+    output   bool
+  } {
+    { "", GlobOptions{}, "", true },
+    { "", GlobOptions{}, "a", false },
+    { "abc", GlobOptions{}, "abc", true },
+    { "a?c", GlobOptions{}, "abc", true },
+    { "a?c", GlobOptions{}, "ac", false },
+    { "a*", GlobOptions{}, "a", true },
+    { "a*c", GlobOptions{}, "abbbc", true },
+    { "a*c", GlobOptions{}, "abbbd", false },
+    { "*a*b*", GlobOptions{}, "xxaxxbxx", true },
+    { "#+*", GlobOptions{}, "#+options:", true },
+    { "[abc]x", GlobOptions{}, "bx", true },
+    { "[a-c]x", GlobOptions{}, "dx", false },
+    { "[!a-c]x", GlobOptions{}, "dx", true },
+    { "[^a-c]x", GlobOptions{}, "ax", false },
+    { "[]a]", GlobOptions{}, "]", true },
+    { "[a-]", GlobOptions{}, "-", true },
+    { "[á-ú]", GlobOptions{}, "ñ", true },
+    { `\*`, GlobOptions{}, "*", true },
+    { `\*`, GlobOptions{}, "a", false },
+    { "*.{go,txt}", GlobOptions{}, "a.txt", true },
+    { "*.{go,txt}", GlobOptions{}, "a.md", false },
+    { "tag{,s}", GlobOptions{}, "tags", true },
+    { "tag{,s}", GlobOptions{}, "tag", true },
+    { "{a,b{c,d}}e", GlobOptions{}, "bde", true },
+    { "{a,b{c,d}}e", GlobOptions{}, "be", false },
+    { "a,b}", GlobOptions{}, "a,b}", true },
+    { "ÑANDÚ", fold, "ñandú", true },
+    { "[a-z]*", fold, "Hola", true },
+    { "k", fold, "K", true },
+    { "a/*", slash, "a/b", true },
+    { "a/*", slash, "a/b/c", false },
+    { "a/?", slash, "a//", false },
+    { "a/**", slash, "a/b/c", true },
+    { "a/**/c", slash, "a/c", true },
+    { "a/**/c", slash, "a/b/d/c", true },
+    { "**/c", slash, "c", true },
+    { "**/c", slash, "a/c", true },
+    { "a**/c", slash, "ab/x/c", true },
+    { "a**/c", slash, "a/c", true },
+    { "a/[!x]", slash, "a//", false },
+  }
+
+  for _, d := range data {
+    g, err := CompileGlob( d.pattern, d.opt )
+    if err != nil {
+      t.Errorf( "CompileGlob( %q, %+v ) \nreturn   %v", d.pattern, d.opt, err )
+      continue
+    }
+
+    if output := g.Match( d.input ); output != d.output {
+      t.Errorf( "CompileGlob( %q, %+v ).Match( %q ) \nreturn   %v\nexpected %v", d.pattern, d.opt, d.input, output, d.output )
+    }
+  }
+}
+
+func TestCompileGlobErrors( t *testing.T ){
+  data := []struct{
+    pattern  string
+    offset   int
+    err      error
+  } {
+    { "a[bc", 1, ErrUnterminatedClass },
+    { "[]", 0, ErrUnterminatedClass },
+    { "a{b,c", 1, ErrUnterminatedBrace },
+    { "{a,{b}", 0, ErrUnterminatedBrace },
+    { `ab\`, 2, ErrUnterminatedEscape },
+  }
+
+  for _, d := range data {
+    _, err := CompileGlob( d.pattern, GlobOptions{} )
+    ge, ok := err.(*GlobError)
+    if !ok || !errors.Is( err, d.err ) || ge.Offset != d.offset {
+      t.Errorf( "CompileGlob( %q ) \nreturn   %v\nexpected %v at offset %d", d.pattern, err, d.err, d.offset )
+    }
+  }
+}
@@ -0,0 +1,119 @@
+package txt
+
+import (
+  "sort"
+  "unicode/utf8"
+)
+
+type ColumnUnit int
+
+const (
+  ByteColumns ColumnUnit = iota
+  RuneColumns
+  UTF16Columns
+  DisplayColumns
+)
+
+// LineIndex maps byte offsets of a document to line and column numbers,
+// both starting at 1. Lines are the ones returned by GetLines, an offset
+// just after a final newline falls on the line that would follow it
+type LineIndex struct {
+  TabWidth int // tab stop of DisplayColumns, DefaultTabWidth when 0
+
+  str   string
+  start []int
+}
+
+func NewLineIndex( str string ) *LineIndex {
+  start := make( []int, 1, 64 )
+  for i := 0; i < len( str ); i++ {
+    if str[i] == '\n' { start = append( start, i + 1 ) }
+  }
+
+  return &LineIndex{ str: str, start: start }
+}
+
+func (li *LineIndex) Lines() int {
+  n := len( li.start )
+  if li.start[n - 1] == len( li.str ) { n-- }
+
+  return n
+}
+
+// Line returns the n-th line without its newline
+func (li *LineIndex) Line( n int ) string {
+  if n < 1 || n > len( li.start ) { return "" }
+
+  return li.str[li.start[n - 1]:li.lineEnd( n )]
+}
+
+func (li *LineIndex) LineStart( n int ) int {
+  if n < 1 { return 0 }
+  if n > len( li.start ) { return len( li.str ) }
+
+  return li.start[n - 1]
+}
+
+func (li *LineIndex) lineEnd( n int ) int {
+  if n < len( li.start ) { return li.start[n] - 1 }
+
+  return len( li.str )
+}
+
+func (li *LineIndex) Position( offset int, unit ColumnUnit ) (line, col int) {
+  if offset < 0 { offset = 0 }
+  if offset > len( li.str ) { offset = len( li.str ) }
+
+  line = sort.Search( len( li.start ), func( i int ) bool { return li.start[i] > offset } )
+  col  = li.columnOf( li.str[li.start[line - 1]:offset], unit ) + 1
+
+  return
+}
+
+// Offset returns the byte offset of a line and column, both clamped to the
+// document, a column inside a multi-byte or wide rune resolves to its start
+func (li *LineIndex) Offset( line, col int, unit ColumnUnit ) int {
+  if line < 1 { return 0 }
+  if line > len( li.start ) { return len( li.str ) }
+
+  start, end := li.start[line - 1], li.lineEnd( line )
+  text := li.str[start:end]
+
+  for i, n := 0, 0; i < len( text ); {
+    c, w := utf8.DecodeRuneInString( text[i:] )
+    next := n + li.width( c, w, n, unit )
+    if n >= col - 1 || next > col - 1 { return start + i }
+    i, n = i + w, next
+  }
+
+  return end
+}
+
+func (li *LineIndex) columnOf( text string, unit ColumnUnit ) (n int) {
+  if unit == ByteColumns { return len( text ) }
+
+  for _, c := range text {
+    n += li.width( c, utf8.RuneLen( c ), n, unit )
+  }
+
+  return
+}
+
+func (li *LineIndex) width( c rune, w, col int, unit ColumnUnit ) int {
+  switch unit {
+  case RuneColumns:
+    return 1
+  case UTF16Columns:
+    if c >= 0x10000 { return 2 }
+    return 1
+  case DisplayColumns:
+    if c == '\t' {
+      tab := li.TabWidth
+      if tab <= 0 { tab = DefaultTabWidth }
+      return tab - col % tab
+    }
+    return RuneWidth( c )
+  }
+
+  return w
+}
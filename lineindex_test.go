@@ -0,0 +1,112 @@
+package txt
+
+import (
+  "testing"
+  "unicode/utf8"
+)
+
+func TestLineIndexLines( t *testing.T ){
+  data := []string{
+    "", "\n", "\n\n\n", "\n\ta", "line", "line\n", "line\n\n", "\n1\n2\n3\n", "1\n2\n3",
+  }
+
+  for _, d := range data {
+    li, lines := NewLineIndex( d ), GetLines( d )
+    if li.Lines() != len( lines ) {
+      t.Errorf( "NewLineIndex( %q ).Lines() \nreturn   %d\nexpected %d", d, li.Lines(), len( lines ) )
+      continue
+    }
+
+    for i, l := range lines {
+      if li.Line( i + 1 ) != l {
+        t.Errorf( "NewLineIndex( %q ).Line( %d ) \nreturn   %q\nexpected %q", d, i + 1, li.Line( i + 1 ), l )
+      }
+    }
+  }
+}
+
+func TestLineIndexPosition( t *testing.T ){
+  const doc = "hola\n\tñandú 😀x\n私は\n"
+
+  data := []struct{
+    offset   int
+    unit     ColumnUnit
+    line     int
+    col      int
+  } {
+    { -1, ByteColumns, 1, 1 },
+    { 0, ByteColumns, 1, 1 },
+    { 4, ByteColumns, 1, 5 },
+    { 5, ByteColumns, 2, 1 },
+    { 13, ByteColumns, 2, 9 },
+    { 13, RuneColumns, 2, 7 },
+    { 13, UTF16Columns, 2, 7 },
+    { 13, DisplayColumns, 2, 14 },
+    { 18, RuneColumns, 2, 9 },
+    { 18, UTF16Columns, 2, 10 },
+    { 18, DisplayColumns, 2, 17 },
+    { 20, ByteColumns, 3, 1 },
+    { 23, DisplayColumns, 3, 3 },
+    { 23, RuneColumns, 3, 2 },
+    { 27, ByteColumns, 4, 1 },
+    { 99, ByteColumns, 4, 1 },
+  }
+
+  li := NewLineIndex( doc )
+  for _, d := range data {
+    line, col := li.Position( d.offset, d.unit )
+    if line != d.line || col != d.col {
+      t.Errorf( "Position( %d, %d ) \nreturn   %d:%d\nexpected %d:%d", d.offset, d.unit, line, col, d.line, d.col )
+    }
+  }
+}
+
+func TestLineIndexOffset( t *testing.T ){
+  const doc = "hola\n\tñandú 😀x\n私は\n"
+
+  data := []struct{
+    line     int
+    col      int
+    unit     ColumnUnit
+    offset   int
+  } {
+    { 0, 1, ByteColumns, 0 },
+    { 1, 1, ByteColumns, 0 },
+    { 1, 3, RuneColumns, 2 },
+    { 1, 99, RuneColumns, 4 },
+    { 2, 1, DisplayColumns, 5 },
+    { 2, 5, DisplayColumns, 5 },
+    { 2, 9, DisplayColumns, 6 },
+    { 2, 8, ByteColumns, 11 },
+    { 2, 9, ByteColumns, 13 },
+    { 2, 7, RuneColumns, 13 },
+    { 2, 9, UTF16Columns, 14 },
+    { 2, 10, UTF16Columns, 18 },
+    { 2, 8, UTF16Columns, 14 },
+    { 2, 17, DisplayColumns, 18 },
+    { 3, 2, DisplayColumns, 20 },
+    { 3, 3, DisplayColumns, 23 },
+    { 4, 1, ByteColumns, 27 },
+    { 9, 1, ByteColumns, 27 },
+  }
+
+  li := NewLineIndex( doc )
+  for _, d := range data {
+    offset := li.Offset( d.line, d.col, d.unit )
+    if offset != d.offset {
+      t.Errorf( "Offset( %d, %d, %d ) \nreturn   %d\nexpected %d", d.line, d.col, d.unit, offset, d.offset )
+    }
+  }
+
+  for off := 0; off <= len( doc ); off++ {
+    if off < len( doc ) && !utf8.RuneStart( doc[off] ) { continue }
+
+    for _, unit := range []ColumnUnit{ ByteColumns, RuneColumns, UTF16Columns, DisplayColumns } {
+      line, col := li.Position( off, unit )
+      back := li.Offset( line, col, unit )
+      if l, c := li.Position( back, unit ); l != line || c != col {
+        t.Errorf( "Offset( Position( %d, %d ) ) \nreturn   %d\nexpected same position %d:%d", off, unit, back, line, col )
+      }
+    }
+  }
+}
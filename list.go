@@ -0,0 +1,149 @@
+package txt
+
+import "strings"
+
+type ListKind int
+
+const (
+  NoList ListKind = iota
+  BulletList
+  OrderedList
+  DefinitionList
+)
+
+// ListMarker describes the marker opening a list item line: Indent is the
+// indentation before the marker and ContentIndent the column where the item
+// text starts, marker width and following spaces included
+type ListMarker struct {
+  Kind          ListKind
+  Marker        string
+  Indent        int
+  ContentIndent int
+}
+
+// ListItem is a list item with its continuation lines. Text holds the item
+// content without the marker and with ContentIndent removed from every line,
+// nested lists included, which are parsed again into Children. Term is the
+// part before " :: " of definition items
+type ListItem struct {
+  ListMarker
+  Term       string
+  Text       string
+  Start, End int
+  Children   []ListItem
+}
+
+// ScanListMarker recognizes bullets ("-", "+", "*"), numbers and letters
+// followed by "." or ")" at the start of line
+func ScanListMarker( line string ) (ListMarker, bool) {
+  indent := CountIndentSpaces( line )
+  rest   := line[indent:]
+
+  n, kind := 0, NoList
+  switch {
+  case rest == "":
+    return ListMarker{}, false
+  case rest[0] == '-', rest[0] == '+', rest[0] == '*':
+    n, kind = 1, BulletList
+  case rest[0] >= '0' && rest[0] <= '9':
+    for n < len( rest ) && n < 9 && rest[n] >= '0' && rest[n] <= '9' { n++ }
+    kind = OrderedList
+  case rest[0] >= 'a' && rest[0] <= 'z', rest[0] >= 'A' && rest[0] <= 'Z':
+    n, kind = 1, OrderedList
+  default:
+    return ListMarker{}, false
+  }
+
+  if kind == OrderedList {
+    if n >= len( rest ) || (rest[n] != '.' && rest[n] != ')') { return ListMarker{}, false }
+    n++
+  }
+
+  if n < len( rest ) && rest[n] != ' ' && rest[n] != '\t' { return ListMarker{}, false }
+
+  spaces := CountIndentSpaces( rest[n:] )
+  if spaces == 0 || n + spaces == len( rest ) { spaces = 1 }
+
+  return ListMarker{ Kind: kind, Marker: rest[:n], Indent: indent, ContentIndent: indent + n + spaces }, true
+}
+
+// DragListItem extracts the list item at the start of str, its continuation
+// lines are the ones indented at least to the item content, blank lines
+// included when more content follows
+func DragListItem( str string ) (ListItem, int) {
+  return dragListItem( str, 0 )
+}
+
+func dragListItem( str string, base int ) (ListItem, int) {
+  line, w := GetLine( str )
+  m, ok := ScanListMarker( line )
+  if !ok { return ListItem{}, 0 }
+
+  body, _ := DragAllTextByIndent( str[w:], m.ContentIndent )
+  body     = trimBlankLines( body )
+  width   := w + len( body )
+
+  head := ""
+  if m.ContentIndent < len( line ) { head = line[m.ContentIndent:] }
+
+  item := ListItem{ ListMarker: m, Start: base, End: base + width }
+  item.Text = head
+  if body != "" { item.Text += "\n" + RmIndent( body, m.ContentIndent ) }
+
+  // the separator is searched before trimming, the definition may be empty
+  if m.Kind == BulletList {
+    if i := strings.Index( head, " :: " ); i >= 0 {
+      item.Kind = DefinitionList
+      item.Term = head[:i]
+      item.Text = RmSpacesAtStartup( item.Text[i + 4:] )
+    } else if strings.HasSuffix( head, " ::" ) {
+      item.Kind = DefinitionList
+      item.Term = head[:len( head ) - 3]
+      item.Text = RmSpacesAtStartup( item.Text[len( head ):] )
+    }
+  }
+
+  item.Text = RmSpacesAtEnd( item.Text )
+
+  inner := str[w:width]
+  for init := 0; init < len( inner ); {
+    sub, n := GetLine( inner[init:] )
+    if s, ok := ScanListMarker( sub ); ok && s.Indent >= m.ContentIndent {
+      children, cw := dragList( inner[init:], base + w + init )
+      item.Children = append( item.Children, children... )
+      n = cw
+    }
+    init += n
+  }
+
+  return item, width
+}
+
+// DragList extracts the run of list items at the start of str sharing the
+// indentation of the first one
+func DragList( str string ) ([]ListItem, int) {
+  return dragList( str, 0 )
+}
+
+func dragList( str string, base int ) ([]ListItem, int) {
+  first, width := dragListItem( str, base )
+  if width == 0 { return nil, 0 }
+
+  r := []ListItem{ first }
+  for init := width; init < len( str ); {
+    line, n := GetLine( str[init:] )
+    if HasOnlySpaces( line ) {
+      init += n
+      continue
+    }
+
+    if m, ok := ScanListMarker( line ); !ok || m.Indent != first.Indent { break }
+
+    item, n := dragListItem( str[init:], base + init )
+    r      = append( r, item )
+    init  += n
+    width  = init
+  }
+
+  return r, width
+}
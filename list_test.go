@@ -0,0 +1,91 @@
+package txt
+
+import "testing"
+
+func TestScanListMarker( t *testing.T ){
+  data := []struct{
+    input    string
+    output   ListMarker
+    ok       bool
+  } {
+    { "", ListMarker{}, false },
+    { "text", ListMarker{}, false },
+    { "-text", ListMarker{}, false },
+    { "e.g. text", ListMarker{}, false },
+    { "1.5 text", ListMarker{}, false },
+    { "- text", ListMarker{ BulletList, "-", 0, 2 }, true },
+    { "  +   text", ListMarker{ BulletList, "+", 2, 6 }, true },
+    { "\t* text", ListMarker{ BulletList, "*", 1, 3 }, true },
+    { "-", ListMarker{ BulletList, "-", 0, 2 }, true },
+    { "1. text", ListMarker{ OrderedList, "1.", 0, 3 }, true },
+    { "  12) text", ListMarker{ OrderedList, "12)", 2, 6 }, true },
+    { "a. text", ListMarker{ OrderedList, "a.", 0, 3 }, true },
+    { "B) text", ListMarker{ OrderedList, "B)", 0, 3 }, true },
+    { "ab. text", ListMarker{}, false },
+    { "1.", ListMarker{ OrderedList, "1.", 0, 3 }, true },
+  }
+
+  for _, d := range data {
+    output, ok := ScanListMarker( d.input )
+    if output != d.output || ok != d.ok {
+      t.Errorf( "ScanListMarker( %q ) \nreturn   %+v, %v\nexpected %+v, %v", d.input, output, ok, d.output, d.ok )
+    }
+  }
+}
+
+func TestDragListItem( t *testing.T ){
+  data := []struct{
+    input    string
+    kind     ListKind
+    term     string
+    text     string
+    n        int
+  } {
+    { "", NoList, "", "", 0 },
+    { "text\n- no", NoList, "", "", 0 },
+    { "- uno", BulletList, "", "uno", 5 },
+    { "- uno\n  dos\ntres", BulletList, "", "uno\ndos", 12 },
+    { "- uno\n\n  dos\n\n- tres", BulletList, "", "uno\n\ndos", 13 },
+    { "- uno\n\n- dos", BulletList, "", "uno", 6 },
+    { "10. uno\n    dos\n   tres", OrderedList, "", "uno\ndos", 16 },
+    { "- term :: the definition\n  goes on", DefinitionList, "term", "the definition\ngoes on", 34 },
+    { "- term ::\n  definition", DefinitionList, "term", "definition", 22 },
+    { "- term :: ", DefinitionList, "term", "", 10 },
+    { "- term ::  \n", DefinitionList, "term", "", 12 },
+    { "- term ::  \n  definition", DefinitionList, "term", "definition", 24 },
+    { "- uno\n  - dos\n    tres\n  - cuatro\n- cinco", BulletList, "", "uno\n- dos\n  tres\n- cuatro", 34 },
+  }
+
+  for _, d := range data {
+    item, n := DragListItem( d.input )
+    if item.Kind != d.kind || item.Term != d.term || item.Text != d.text || n != d.n || item.End != n {
+      t.Errorf( "DragListItem( %q ) \nreturn   [%d] %d %q %q\nexpected [%d] %d %q %q", d.input, n, item.Kind, item.Term, item.Text, d.n, d.kind, d.term, d.text )
+    }
+  }
+}
+
+func TestDragList( t *testing.T ){
+  const doc = "- uno\n  - dos\n    tres\n\n  - cuatro\n    1. cinco\n- seis\n\n- siete\n\nend"
+
+  items, n := DragList( doc )
+  if n != 64 || len( items ) != 3 {
+    t.Fatalf( "DragList( %q ) \nreturn   [%d] %d items\nexpected [64] 3 items", doc, n, len( items ) )
+  }
+
+  if items[1].Text != "seis" || doc[items[2].Start:items[2].End] != "- siete\n" {
+    t.Errorf( "DragList( %q ) \nreturn   %+v", doc, items )
+  }
+
+  sub := items[0].Children
+  if len( sub ) != 2 || sub[0].Text != "dos\ntres" || sub[1].Text != "cuatro\n1. cinco" {
+    t.Fatalf( "DragList( %q )[0].Children \nreturn   %+v", doc, sub )
+  }
+
+  if doc[sub[1].Start:sub[1].End] != "  - cuatro\n    1. cinco\n" || len( sub[1].Children ) != 1 {
+    t.Errorf( "DragList( %q )[0].Children[1] \nreturn   %+v", doc, sub[1] )
+  }
+
+  if c := sub[1].Children[0]; c.Kind != OrderedList || c.Marker != "1." || c.Text != "cinco" || c.Indent != 4 {
+    t.Errorf( "DragList( %q )[0].Children[1].Children[0] \nreturn   %+v", doc, c )
+  }
+}
@@ -0,0 +1,100 @@
+package txt
+
+import (
+  "errors"
+  "sort"
+  "strconv"
+)
+
+var (
+  ErrOutOfRange       = errors.New( "txt: position out of range" )
+  ErrOverlappingEdits = errors.New( "txt: overlapping edits" )
+)
+
+// LSPPosition is a Language Server Protocol position: zero based line and
+// character, counting characters as UTF-16 code units
+type LSPPosition struct {
+  Line      int `json:"line"`
+  Character int `json:"character"`
+}
+
+type LSPRange struct {
+  Start LSPPosition `json:"start"`
+  End   LSPPosition `json:"end"`
+}
+
+type TextEdit struct {
+  Range   LSPRange `json:"range"`
+  NewText string   `json:"newText"`
+}
+
+type EditError struct {
+  Index int
+  Err   error
+}
+
+func (e *EditError) Error() string {
+  return e.Err.Error() + " in edit " + strconv.Itoa( e.Index )
+}
+
+func (e *EditError) Unwrap() error { return e.Err }
+
+func (li *LineIndex) ToLSP( offset int ) LSPPosition {
+  line, col := li.Position( offset, UTF16Columns )
+
+  return LSPPosition{ Line: line - 1, Character: col - 1 }
+}
+
+// FromLSP returns the byte offset of p. As the protocol asks, a character
+// past the end of its line means the line end, a carriage return before the
+// newline is not part of the line
+func (li *LineIndex) FromLSP( p LSPPosition ) (int, error) {
+  if p.Line < 0 || p.Character < 0 || p.Line >= len( li.start ) {
+    return 0, ErrOutOfRange
+  }
+
+  offset := li.Offset( p.Line + 1, p.Character + 1, UTF16Columns )
+  if end := li.lineEnd( p.Line + 1 ); offset == end && end > li.start[p.Line] && li.str[end - 1] == '\r' {
+    offset--
+  }
+
+  return offset, nil
+}
+
+// ApplyEdits applies a batch of edits whose ranges refer to str before any
+// of them is applied. Edits inserting at the same position keep their order
+// and go before an edit replacing text from that position
+func ApplyEdits( str string, edits []TextEdit ) (string, error) {
+  type span struct{ start, end, index int }
+
+  li    := NewLineIndex( str )
+  spans := make( []span, len( edits ) )
+  size  := len( str )
+
+  for i, e := range edits {
+    start, err := li.FromLSP( e.Range.Start )
+    if err != nil { return "", &EditError{ i, err } }
+    end, err := li.FromLSP( e.Range.End )
+    if err != nil { return "", &EditError{ i, err } }
+    if end < start { return "", &EditError{ i, ErrOutOfRange } }
+
+    spans[i] = span{ start, end, i }
+    size += len( e.NewText ) - (end - start)
+  }
+
+  sort.SliceStable( spans, func( i, j int ) bool {
+    if spans[i].start != spans[j].start { return spans[i].start < spans[j].start }
+    return spans[i].start == spans[i].end && spans[j].start != spans[j].end
+  })
+
+  k, last := make( []byte, 0, size ), 0
+  for i, s := range spans {
+    if i > 0 && spans[i - 1].end > s.start { return "", &EditError{ s.index, ErrOverlappingEdits } }
+
+    k    = append( k, str[last:s.start]... )
+    k    = append( k, edits[s.index].NewText... )
+    last = s.end
+  }
+
+  return string( append( k, str[last:]... ) ), nil
+}
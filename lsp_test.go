@@ -0,0 +1,96 @@
+package txt
+
+import (
+  "errors"
+  "testing"
+)
+
+func TestLSPPosition( t *testing.T ){
+  const doc = "hola\r\n\tñ😀x\n"
+
+  data := []struct{
+    offset   int
+    pos      LSPPosition
+  } {
+    { 0, LSPPosition{ 0, 0 } },
+    { 4, LSPPosition{ 0, 4 } },
+    { 6, LSPPosition{ 1, 0 } },
+    { 7, LSPPosition{ 1, 1 } },
+    { 9, LSPPosition{ 1, 2 } },
+    { 13, LSPPosition{ 1, 4 } },
+    { 15, LSPPosition{ 2, 0 } },
+  }
+
+  li := NewLineIndex( doc )
+  for _, d := range data {
+    pos := li.ToLSP( d.offset )
+    if pos != d.pos {
+      t.Errorf( "ToLSP( %d ) \nreturn   %v\nexpected %v", d.offset, pos, d.pos )
+    }
+
+    offset, err := li.FromLSP( d.pos )
+    if err != nil || offset != d.offset {
+      t.Errorf( "FromLSP( %v ) \nreturn   %d, %v\nexpected %d", d.pos, offset, err, d.offset )
+    }
+  }
+}
+
+func TestFromLSP( t *testing.T ){
+  const doc = "hola\r\n\tñ😀x\n"
+
+  data := []struct{
+    pos      LSPPosition
+    offset   int
+    err      error
+  } {
+    { LSPPosition{ 0, 99 }, 4, nil },
+    { LSPPosition{ 1, 3 }, 9, nil },
+    { LSPPosition{ 1, 99 }, 14, nil },
+    { LSPPosition{ 2, 5 }, 15, nil },
+    { LSPPosition{ 3, 0 }, 0, ErrOutOfRange },
+    { LSPPosition{ -1, 0 }, 0, ErrOutOfRange },
+    { LSPPosition{ 0, -1 }, 0, ErrOutOfRange },
+  }
+
+  li := NewLineIndex( doc )
+  for _, d := range data {
+    offset, err := li.FromLSP( d.pos )
+    if err != d.err || offset != d.offset {
+      t.Errorf( "FromLSP( %v ) \nreturn   %d, %v\nexpected %d, %v", d.pos, offset, err, d.offset, d.err )
+    }
+  }
+}
+
+func TestApplyEdits( t *testing.T ){
+  edit := func( l1, c1, l2, c2 int, text string ) TextEdit {
+    return TextEdit{ LSPRange{ LSPPosition{ l1, c1 }, LSPPosition{ l2, c2 } }, text }
+  }
+
+  data := []struct{
+    input    string
+    edits    []TextEdit
+    output   string
+    err      error
+  } {
+    { "", nil, "", nil },
+    { "", []TextEdit{ edit( 0, 0, 0, 0, "hola" ) }, "hola", nil },
+    { "hola mundo", []TextEdit{ edit( 0, 5, 0, 10, "world" ), edit( 0, 0, 0, 4, "hello" ) }, "hello world", nil },
+    { "a\nb\nc\n", []TextEdit{ edit( 0, 1, 2, 0, "" ) }, "ac\n", nil },
+    { "a😀b", []TextEdit{ edit( 0, 1, 0, 3, "-" ) }, "a-b", nil },
+    { "ab", []TextEdit{ edit( 0, 1, 0, 1, "1" ), edit( 0, 1, 0, 1, "2" ) }, "a12b", nil },
+    { "ab", []TextEdit{ edit( 0, 1, 0, 1, "x" ), edit( 0, 1, 0, 2, "y" ) }, "axy", nil },
+    { "ab", []TextEdit{ edit( 0, 1, 0, 2, "y" ), edit( 0, 1, 0, 1, "x" ) }, "axy", nil },
+    { "ab", []TextEdit{ edit( 0, 1, 0, 2, "y" ), edit( 0, 1, 0, 1, "1" ), edit( 0, 1, 0, 1, "2" ) }, "a12y", nil },
+    { "a\n", []TextEdit{ edit( 1, 0, 1, 0, "b\n" ) }, "a\nb\n", nil },
+    { "abc", []TextEdit{ edit( 0, 0, 0, 2, "x" ), edit( 0, 1, 0, 3, "y" ) }, "", ErrOverlappingEdits },
+    { "abc", []TextEdit{ edit( 0, 2, 0, 1, "x" ) }, "", ErrOutOfRange },
+    { "abc", []TextEdit{ edit( 0, 0, 4, 0, "x" ) }, "", ErrOutOfRange },
+  }
+
+  for _, d := range data {
+    output, err := ApplyEdits( d.input, d.edits )
+    if !errors.Is( err, d.err ) || output != d.output {
+      t.Errorf( "ApplyEdits( %q, %v ) \nreturn   %q, %v\nexpected %q, %v", d.input, d.edits, output, err, d.output, d.err )
+    }
+  }
+}
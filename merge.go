@@ -0,0 +1,135 @@
+package txt
+
+import "strings"
+
+type MergeStyle int
+
+const (
+  MergeMarkers MergeStyle = iota // ours and theirs, as git's merge style
+  Diff3Markers                   // also the base between them
+  ZDiff3Markers                  // as Diff3Markers, with lines common to both sides out of the conflict
+)
+
+type MergeOptions struct {
+  Style       MergeStyle
+  OursLabel   string // "ours" when empty
+  BaseLabel   string // "base" when empty
+  TheirsLabel string // "theirs" when empty
+}
+
+// Conflict is a region of the merged text, [Start, End) in bytes with the
+// markers included, where ours and theirs changed base in different ways
+type Conflict struct {
+  Start, End         int
+  Base, Ours, Theirs string
+}
+
+// Merge3 merges the changes from base to ours and from base to theirs line
+// by line, as diff3 does. Changes made by one side only, or equally by
+// both, are taken, the rest are written between conflict markers
+func Merge3( base, ours, theirs string, opt MergeOptions ) (string, []Conflict) {
+  lb, lo, lt := GetRawLines( base ), GetRawLines( ours ), GetRawLines( theirs )
+  mo, mt     := baseMatches( lb, lo ), baseMatches( lb, lt )
+
+  var k strings.Builder
+  var conflicts []Conflict
+
+  for i, j, n := 0, 0, 0; i < len( lb ) || j < len( lo ) || n < len( lt ); {
+    if i < len( lb ) && mo[i] == j && mt[i] == n {
+      k.WriteString( lb[i] )
+      i, j, n = i + 1, j + 1, n + 1
+      continue
+    }
+
+    si, sj, sn := i, len( lo ), len( lt )
+    for ; si < len( lb ); si++ {
+      if mo[si] >= 0 && mt[si] >= 0 {
+        sj, sn = mo[si], mt[si]
+        break
+      }
+    }
+
+    b, o, t := lb[i:si], lo[j:sj], lt[n:sn]
+    switch {
+    case cmpLines( o, b ): writeLines( &k, t )
+    case cmpLines( t, b ), cmpLines( o, t ): writeLines( &k, o )
+    default:
+      c := Conflict{ Base: strings.Join( b, "" ), Ours: strings.Join( o, "" ), Theirs: strings.Join( t, "" ) }
+      writeConflict( &k, b, o, t, opt, &c )
+      conflicts = append( conflicts, c )
+    }
+
+    i, j, n = si, sj, sn
+  }
+
+  return k.String(), conflicts
+}
+
+// baseMatches maps each line of base to the line of other it is kept as,
+// or -1 when it is changed
+func baseMatches( base, other []string ) []int {
+  m := make( []int, len( base ) )
+  for i := range m {
+    m[i] = -1
+  }
+
+  for _, e := range DiffStrings( base, other, Myers ) {
+    if e.Op != DiffEqual { continue }
+    for i := e.AStart; i < e.AEnd; i++ {
+      m[i] = e.BStart + i - e.AStart
+    }
+  }
+
+  return m
+}
+
+func cmpLines( a, b []string ) bool {
+  if len( a ) != len( b ) { return false }
+
+  for i := range a {
+    if a[i] != b[i] { return false }
+  }
+
+  return true
+}
+
+func writeLines( k *strings.Builder, lines []string ) {
+  for _, l := range lines {
+    k.WriteString( l )
+  }
+}
+
+func writeConflict( k *strings.Builder, base, ours, theirs []string, opt MergeOptions, c *Conflict ) {
+  if opt.Style == ZDiff3Markers {
+    p := 0
+    for p < len( ours ) && p < len( theirs ) && ours[p] == theirs[p] { p++ }
+    s := 0
+    for s < len( ours ) - p && s < len( theirs ) - p && ours[len( ours ) - 1 - s] == theirs[len( theirs ) - 1 - s] { s++ }
+
+    writeLines( k, ours[:p] )
+    defer writeLines( k, ours[len( ours ) - s:] )
+    ours, theirs = ours[p:len( ours ) - s], theirs[p:len( theirs ) - s]
+  }
+
+  label := func( l, def string ) string {
+    if l == "" { return def }
+    return l
+  }
+
+  c.Start = k.Len()
+  k.WriteString( "<<<<<<< " + label( opt.OursLabel, "ours" ) + "\n" )
+  writeConflictLines( k, ours )
+  if opt.Style != MergeMarkers {
+    k.WriteString( "||||||| " + label( opt.BaseLabel, "base" ) + "\n" )
+    writeConflictLines( k, base )
+  }
+  k.WriteString( "=======\n" )
+  writeConflictLines( k, theirs )
+  k.WriteString( ">>>>>>> " + label( opt.TheirsLabel, "theirs" ) + "\n" )
+  c.End = k.Len()
+}
+
+func writeConflictLines( k *strings.Builder, lines []string ) {
+  writeLines( k, lines )
+  if n := len( lines ); n > 0 && !strings.HasSuffix( lines[n - 1], "\n" ) { k.WriteByte( '\n' ) }
+}
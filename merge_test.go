@@ -0,0 +1,68 @@
+package txt
+
+import (
+  "strings"
+  "testing"
+)
+
+func TestMerge3( t *testing.T ){
+  const base = "a\nb\nc\nd\ne\n"
+
+  data := []struct{
+    ours, theirs string
+    output       string
+    conflicts    int
+  } {
+    { base, base, base, 0 },
+    { "a\nB\nc\nd\ne\n", base, "a\nB\nc\nd\ne\n", 0 },
+    { base, "a\nb\nc\nD\ne\n", "a\nb\nc\nD\ne\n", 0 },
+    { "a\nB\nc\nd\ne\n", "a\nb\nc\nD\ne\n", "a\nB\nc\nD\ne\n", 0 },
+    { "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", 0 },
+    { "x\n" + base, base + "y\n", "x\n" + base + "y\n", 0 },
+    { "a\nc\nd\ne\n", "a\nb\nc\nd\n", "a\nc\nd\n", 0 },
+    { "a\nB\nc\nd\ne\n", "a\nX\nc\nd\ne\n", "a\n<<<<<<< ours\nB\n=======\nX\n>>>>>>> theirs\nc\nd\ne\n", 1 },
+    { "a\nb\nc\nd\nE", "a\nb\nc\nd\nF", "a\nb\nc\nd\n<<<<<<< ours\nE\n=======\nF\n>>>>>>> theirs\n", 1 },
+  }
+
+  for _, d := range data {
+    output, conflicts := Merge3( base, d.ours, d.theirs, MergeOptions{} )
+    if output != d.output || len( conflicts ) != d.conflicts {
+      t.Errorf( "Merge3( %q, %q, %q ) \nreturn   %q %d\nexpected %q %d", base, d.ours, d.theirs, output, len( conflicts ), d.output, d.conflicts )
+    }
+  }
+}
+
+func TestMerge3Styles( t *testing.T ){
+  const base   = "a\nb\nc\n"
+  const ours   = "a\nx\nb1\ny\nc\n"
+  const theirs = "a\nx\nb2\ny\nc\n"
+
+  data := []struct{
+    opt      MergeOptions
+    output   string
+  } {
+    { MergeOptions{ Style: MergeMarkers },
+      "a\n<<<<<<< ours\nx\nb1\ny\n=======\nx\nb2\ny\n>>>>>>> theirs\nc\n" },
+    { MergeOptions{ Style: Diff3Markers, OursLabel: "HEAD", BaseLabel: "merged common ancestors", TheirsLabel: "topic" },
+      "a\n<<<<<<< HEAD\nx\nb1\ny\n||||||| merged common ancestors\nb\n=======\nx\nb2\ny\n>>>>>>> topic\nc\n" },
+    { MergeOptions{ Style: ZDiff3Markers },
+      "a\nx\n<<<<<<< ours\nb1\n||||||| base\nb\n=======\nb2\n>>>>>>> theirs\ny\nc\n" },
+  }
+
+  for _, d := range data {
+    output, conflicts := Merge3( base, ours, theirs, d.opt )
+    if output != d.output || len( conflicts ) != 1 {
+      t.Errorf( "Merge3( %q, %q, %q, %+v ) \nreturn\n%s\nexpected\n%s", base, ours, theirs, d.opt, output, d.output )
+      continue
+    }
+
+    c := conflicts[0]
+    if c.Base != "b\n" || c.Ours != "x\nb1\ny\n" || c.Theirs != "x\nb2\ny\n" {
+      t.Errorf( "Merge3( %q, %q, %q, %+v ) \nreturn   %+v", base, ours, theirs, d.opt, c )
+    }
+
+    if !strings.HasPrefix( output[c.Start:], "<<<<<<< " ) || !strings.HasPrefix( output[c.End:], "c\n" ) && !strings.HasPrefix( output[c.End:], "y\nc\n" ) {
+      t.Errorf( "Merge3( %q, %q, %q, %+v ) conflict [%d:%d] \nreturn   %q", base, ours, theirs, d.opt, c.Start, c.End, output[c.Start:c.End] )
+    }
+  }
+}
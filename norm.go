@@ -0,0 +1,234 @@
+package txt
+
+//go:generate go run gen_unicode.go -version 14.0.0
+
+import (
+  "sort"
+)
+
+// Form is a Unicode normalization form, the zero Form leaves text as it is
+type Form int
+
+const (
+  NFC Form = iota + 1 // canonical decomposition and composition
+  NFD                 // canonical decomposition
+  NFKC                // compatibility decomposition, canonical composition
+  NFKD                // compatibility decomposition
+)
+
+type QuickCheckResult int
+
+const (
+  QuickCheckYes   QuickCheckResult = iota // normalized
+  QuickCheckNo                            // not normalized
+  QuickCheckMaybe                         // only a full normalization can tell
+)
+
+type cccRange struct {
+  lo, hi rune
+  ccc    uint8
+}
+
+const (
+  hangulSBase  = 0xAC00
+  hangulLBase  = 0x1100
+  hangulVBase  = 0x1161
+  hangulTBase  = 0x11A7
+  hangulLCount = 19
+  hangulVCount = 21
+  hangulTCount = 28
+  hangulNCount = hangulVCount * hangulTCount
+  hangulSCount = hangulLCount * hangulNCount
+)
+
+// sets derived from the tables: runes with a canonical decomposition that
+// never appears in NFC, and runes that may compose with what precedes them
+var nfcNo, composeMaybe = normSets()
+
+func normSets() (map[rune]bool, map[rune]bool) {
+  composite := make( map[rune]bool, len( composeTable ) )
+  maybe     := make( map[rune]bool )
+  for pair, c := range composeTable {
+    composite[c]   = true
+    maybe[pair[1]] = true
+  }
+
+  no := make( map[rune]bool )
+  for c := range canonDecomp {
+    if !composite[c] { no[c] = true }
+  }
+
+  return no, maybe
+}
+
+func combiningClass( c rune ) uint8 {
+  if c < 0x300 { return 0 }
+
+  i := sort.Search( len( cccTable ), func( i int ) bool { return cccTable[i].hi >= c } )
+  if i < len( cccTable ) && cccTable[i].lo <= c { return cccTable[i].ccc }
+
+  return 0
+}
+
+func isHangulSyllable( c rune ) bool {
+  return hangulSBase <= c && c < hangulSBase + hangulSCount
+}
+
+// QuickCheck tells, without normalizing, whether str is in form f
+func (f Form) QuickCheck( str string ) QuickCheckResult {
+  if f == 0 { return QuickCheckYes }
+
+  r    := QuickCheckYes
+  last := uint8( 0 )
+  for _, c := range str {
+    if c < 0xA0 {
+      last = 0
+      continue
+    }
+
+    ccc := combiningClass( c )
+    if ccc != 0 && last > ccc { return QuickCheckNo }
+    last = ccc
+
+    switch f {
+    case NFD, NFKD:
+      if isHangulSyllable( c ) { return QuickCheckNo }
+      if _, ok := canonDecomp[c]; ok { return QuickCheckNo }
+      if _, ok := compatDecomp[c]; ok && f == NFKD { return QuickCheckNo }
+    case NFC, NFKC:
+      if nfcNo[c] { return QuickCheckNo }
+      if _, ok := compatDecomp[c]; ok && f == NFKC { return QuickCheckNo }
+      if composeMaybe[c] || (hangulVBase <= c && c < hangulTBase + hangulTCount) { r = QuickCheckMaybe }
+    }
+  }
+
+  return r
+}
+
+func (f Form) IsNormalized( str string ) bool {
+  switch f.QuickCheck( str ) {
+  case QuickCheckYes: return true
+  case QuickCheckNo : return false
+  }
+
+  return f.normalize( str ) == str
+}
+
+// String returns str in form f, str itself when it passes QuickCheck
+func (f Form) String( str string ) string {
+  if f.QuickCheck( str ) == QuickCheckYes { return str }
+
+  return f.normalize( str )
+}
+
+func (f Form) normalize( str string ) string {
+  rs := make( []rune, 0, len( str ) + 8 )
+  for _, c := range str {
+    rs = f.decompose( rs, c )
+  }
+
+  reorder( rs )
+  if f == NFC || f == NFKC { rs = compose( rs ) }
+
+  k := make( []byte, 0, len( str ) + 8 )
+  for _, c := range rs {
+    k = append( k, string( c )... )
+  }
+
+  return string( k )
+}
+
+func (f Form) decompose( rs []rune, c rune ) []rune {
+  if c < 0xA0 { return append( rs, c ) }
+
+  if isHangulSyllable( c ) {
+    s := c - hangulSBase
+    rs = append( rs, hangulLBase + s / hangulNCount, hangulVBase + (s % hangulNCount) / hangulTCount )
+    if t := s % hangulTCount; t != 0 { rs = append( rs, hangulTBase + t ) }
+    return rs
+  }
+
+  if f == NFKC || f == NFKD {
+    if d, ok := compatDecomp[c]; ok { return append( rs, []rune( d )... ) }
+  }
+  if d, ok := canonDecomp[c]; ok { return append( rs, []rune( d )... ) }
+
+  return append( rs, c )
+}
+
+// reorder sorts every run of non-starters by combining class, keeping the
+// order of runes of the same class
+func reorder( rs []rune ) {
+  for i := 1; i < len( rs ); i++ {
+    ccc := combiningClass( rs[i] )
+    if ccc == 0 { continue }
+
+    for j := i; j > 0; j-- {
+      p := combiningClass( rs[j - 1] )
+      if p == 0 || p <= ccc { break }
+      rs[j], rs[j - 1] = rs[j - 1], rs[j]
+    }
+  }
+}
+
+// compose applies the canonical composition algorithm to a decomposed and
+// reordered sequence
+func compose( rs []rune ) []rune {
+  if len( rs ) == 0 { return rs }
+
+  starter, last := -1, uint8( 0 )
+  if combiningClass( rs[0] ) == 0 { starter = 0 }
+  k := rs[:1]
+
+  for _, c := range rs[1:] {
+    ccc := combiningClass( c )
+
+    if starter >= 0 && (last < ccc || (last == 0 && len( k ) - 1 == starter)) {
+      if p, ok := composePair( k[starter], c ); ok {
+        k[starter] = p
+        continue
+      }
+    }
+
+    if ccc == 0 {
+      starter = len( k )
+      last    = 0
+    } else {
+      last = ccc
+    }
+    k = append( k, c )
+  }
+
+  return k
+}
+
+func composePair( a, b rune ) (rune, bool) {
+  if hangulLBase <= a && a < hangulLBase + hangulLCount && hangulVBase <= b && b < hangulVBase + hangulVCount {
+    return hangulSBase + ((a - hangulLBase) * hangulVCount + b - hangulVBase) * hangulTCount, true
+  }
+
+  if isHangulSyllable( a ) && (a - hangulSBase) % hangulTCount == 0 && hangulTBase < b && b < hangulTBase + hangulTCount {
+    return a + b - hangulTBase, true
+  }
+
+  c, ok := composeTable[[2]rune{ a, b }]
+  return c, ok
+}
+
+// Tokenize works as the package Tokenize, returning tokens in form f
+func (f Form) Tokenize( str string ) []string {
+  r := Tokenize( str )
+  for i := range r {
+    r[i] = f.String( r[i] )
+  }
+
+  return r
+}
+
+func (f Form) RmSpacesToTheSides( str string ) string {
+  return f.String( RmSpacesToTheSides( str ) )
+}
+
+func (f Form) Linelize( str string ) string {
+  return f.String( Linelize( str ) )
+}
@@ -0,0 +1,89 @@
+package txt
+
+import (
+  "testing"
+)
+
+func TestFormString( t *testing.T ){
+  data := []struct{
+    form     Form
+    input    string
+    output   string
+  } {
+    { 0, "e\u0301", "e\u0301" },
+    { NFC, "", "" },
+    { NFC, "\u00f1and\u00fa", "\u00f1and\u00fa" },
+    { NFC, "n\u0303andu\u0301", "\u00f1and\u00fa" },
+    { NFD, "\u00f1and\u00fa", "n\u0303andu\u0301" },
+    { NFC, "a\u0323\u0302", "\u1ead" },
+    { NFC, "a\u0302\u0323", "\u1ead" },
+    { NFD, "\u1ead", "a\u0323\u0302" },
+    { NFC, "\u212b", "\u00c5" },
+    { NFC, "\u0958", "\u0915\u093c" },
+    { NFC, "\ufb01", "\ufb01" },
+    { NFKC, "\ufb01", "fi" },
+    { NFKD, "\u1e9b\u0323", "s\u0323\u0307" },
+    { NFKC, "\u1e9b\u0323", "\u1e69" },
+    { NFC, "\ud55c\uae00", "\ud55c\uae00" },
+    { NFD, "\ud55c", "\u1112\u1161\u11ab" },
+    { NFC, "\u1112\u1161\u11ab", "\ud55c" },
+    { NFKC, "\u2460\u00b2", "12" },
+  }
+
+  for _, d := range data {
+    output := d.form.String( d.input )
+    if output != d.output {
+      t.Errorf( "Form( %d ).String( %+q ) \nreturn   %+q\nexpected %+q", d.form, d.input, output, d.output )
+    }
+  }
+}
+
+func TestFormQuickCheck( t *testing.T ){
+  data := []struct{
+    form     Form
+    input    string
+    output   QuickCheckResult
+  } {
+    { NFC, "hola", QuickCheckYes },
+    { NFC, "\u00f1and\u00fa", QuickCheckYes },
+    { NFD, "\u00f1and\u00fa", QuickCheckNo },
+    { NFC, "n\u0303", QuickCheckMaybe },
+    { NFD, "n\u0303", QuickCheckYes },
+    { NFC, "\u212b", QuickCheckNo },
+    { NFD, "a\u0302\u0323", QuickCheckNo },
+    { NFC, "\ufb01", QuickCheckYes },
+    { NFKC, "\ufb01", QuickCheckNo },
+    { NFKD, "a\u00a0b", QuickCheckNo },
+  }
+
+  for _, d := range data {
+    output := d.form.QuickCheck( d.input )
+    if output != d.output {
+      t.Errorf( "Form( %d ).QuickCheck( %+q ) \nreturn   %d\nexpected %d", d.form, d.input, output, d.output )
+    }
+  }
+
+  if !NFC.IsNormalized( "\u00f1" ) || NFC.IsNormalized( "n\u0303" ) || !NFD.IsNormalized( "n\u0303" ) {
+    t.Errorf( "IsNormalized disagrees with String" )
+  }
+}
+
+func TestFormWhitespace( t *testing.T ){
+  if output := NFC.RmSpacesToTheSides( "  n\u0303andu\u0301 \n" ); output != "\u00f1and\u00fa" {
+    t.Errorf( "NFC.RmSpacesToTheSides() \nreturn   %+q\nexpected %+q", output, "\u00f1and\u00fa" )
+  }
+
+  if output := NFC.Linelize( "el\n  n\u0303andu\u0301\n" ); output != "el \u00f1and\u00fa" {
+    t.Errorf( "NFC.Linelize() \nreturn   %+q\nexpected %+q", output, "el \u00f1and\u00fa" )
+  }
+
+  expected := []string{ "el", "\u00f1and\u00fa" }
+  if output := NFC.Tokenize( " el n\u0303andu\u0301 " ); !cmpStringArray( output, expected ) {
+    t.Errorf( "NFC.Tokenize() \nreturn   %+q\nexpected %+q", output, expected )
+  }
+
+  tk := Tokenizer{ Quotes: `"`, Form: NFC }
+  if output, err := tk.Tokenize( "el \"n\u0303andu\u0301 veloz\"" ); err != nil || !cmpStringArray( output, []string{ "el", "\u00f1and\u00fa veloz" } ) {
+    t.Errorf( "Tokenizer{ Form: NFC }.Tokenize() \nreturn   %+q, %v\nexpected %+q", output, err, []string{ "el", "\u00f1and\u00fa veloz" } )
+  }
+}
@@ -0,0 +1,234 @@
+package txt
+
+import (
+  "errors"
+  "strconv"
+  "strings"
+)
+
+var ErrMalformedPatch = errors.New( "txt: malformed patch" )
+
+type PatchError struct {
+  Line int
+  Err  error
+}
+
+func (e *PatchError) Error() string {
+  return e.Err.Error() + " at line " + strconv.Itoa( e.Line )
+}
+
+func (e *PatchError) Unwrap() error { return e.Err }
+
+// Hunk is a unified diff hunk. Lines keep their ' ', '-' or '+' mark and
+// their newline, dropped when a "\ No newline at end of file" follows
+type Hunk struct {
+  OldStart, OldLines int
+  NewStart, NewLines int
+  Lines              []string
+}
+
+type Patch struct {
+  OldName, NewName string
+  Hunks            []Hunk
+}
+
+// ParsePatch reads the file patches of a unified diff, text outside of them
+// is ignored as patch(1) does. Hunks with no file header before them form a
+// patch without names
+func ParsePatch( str string ) ([]Patch, error) {
+  var r []Patch
+
+  lines := GetRawLines( str )
+  for i := 0; i < len( lines ); i++ {
+    line := lines[i]
+
+    switch {
+    case strings.HasPrefix( line, "--- " ) && i + 1 < len( lines ) && strings.HasPrefix( lines[i + 1], "+++ " ):
+      r = append( r, Patch{ OldName: patchName( line[4:] ), NewName: patchName( lines[i + 1][4:] ) } )
+      i++
+    case strings.HasPrefix( line, "@@ " ):
+      if len( r ) == 0 { r = append( r, Patch{} ) }
+
+      h, n, err := parseHunk( lines[i:] )
+      if err != nil { return nil, &PatchError{ i + 1 + n, err } }
+
+      p := &r[len( r ) - 1]
+      p.Hunks = append( p.Hunks, h )
+      i += n - 1
+    }
+  }
+
+  return r, nil
+}
+
+func patchName( str string ) string {
+  str = strings.TrimRight( str, "\r\n" )
+  if i := strings.IndexByte( str, '\t' ); i >= 0 { str = str[:i] }
+
+  return str
+}
+
+// parseHunk reads the hunk starting at lines[0], returning it with the
+// number of lines read, or the offset of the offending line
+func parseHunk( lines []string ) (Hunk, int, error) {
+  var h Hunk
+
+  fields := strings.Fields( lines[0] )
+  if len( fields ) < 4 || fields[3] != "@@" || !strings.HasPrefix( fields[1], "-" ) || !strings.HasPrefix( fields[2], "+" ) {
+    return h, 0, ErrMalformedPatch
+  }
+
+  var ok1, ok2 bool
+  h.OldStart, h.OldLines, ok1 = hunkRangeOf( fields[1][1:] )
+  h.NewStart, h.NewLines, ok2 = hunkRangeOf( fields[2][1:] )
+  if !ok1 || !ok2 { return h, 0, ErrMalformedPatch }
+
+  n, oldN, newN := 1, 0, 0
+  for ; n < len( lines ) && (oldN < h.OldLines || newN < h.NewLines || strings.HasPrefix( lines[n], `\` )); n++ {
+    line := lines[n]
+
+    switch {
+    case line == "\n" || line == "\r\n":
+      line = " " + line
+      fallthrough
+    case line[0] == ' ':
+      oldN++
+      newN++
+    case line[0] == '-':
+      oldN++
+    case line[0] == '+':
+      newN++
+    case line[0] == '\\':
+      if len( h.Lines ) == 0 { return h, n, ErrMalformedPatch }
+      last := h.Lines[len( h.Lines ) - 1]
+      h.Lines[len( h.Lines ) - 1] = strings.TrimSuffix( last, "\n" )
+      continue
+    default:
+      return h, n, ErrMalformedPatch
+    }
+
+    h.Lines = append( h.Lines, line )
+  }
+
+  if oldN != h.OldLines || newN != h.NewLines { return h, n, ErrMalformedPatch }
+
+  return h, n, nil
+}
+
+func hunkRangeOf( str string ) (start, n int, ok bool) {
+  n = 1
+  if i := strings.IndexByte( str, ',' ); i >= 0 {
+    var err error
+    if n, err = strconv.Atoi( str[i + 1:] ); err != nil || n < 0 { return 0, 0, false }
+    str = str[:i]
+  }
+
+  start, err := strconv.Atoi( str )
+  return start, n, err == nil && start >= 0
+}
+
+type HunkStatus int
+
+const (
+  HunkApplied  HunkStatus = iota // applied where the hunk says
+  HunkOffset                     // applied some lines away
+  HunkFuzz                       // applied ignoring some context lines
+  HunkRejected
+)
+
+// HunkResult tells how a hunk was applied: Line is where it landed in the
+// result, starting at 1, Offset its distance from the place stated by the
+// hunk and Fuzz the context lines ignored at each end
+type HunkResult struct {
+  Status HunkStatus
+  Line   int
+  Offset int
+  Fuzz   int
+}
+
+// ApplyPatch applies the hunks of p to str in order. A hunk not matching
+// where it says is searched at growing distances, and with up to fuzz
+// context lines ignored at its start and end, as patch(1) does
+func ApplyPatch( str string, p Patch, fuzz int ) (string, []HunkResult) {
+  text    := GetRawLines( str )
+  results := make( []HunkResult, len( p.Hunks ) )
+  delta, last, hint := 0, 0, 0
+
+  for n, h := range p.Hunks {
+    old, new := hunkSides( h )
+    lead, trail := contextRun( h.Lines, false ), contextRun( h.Lines, true )
+
+    expected := h.OldStart - 1 + delta
+    if h.OldLines == 0 { expected++ }
+
+    results[n] = HunkResult{ Status: HunkRejected }
+
+    for f := 0; f <= fuzz; f++ {
+      fl, ft := f, f
+      if fl > lead { fl = lead }
+      if ft > trail { ft = trail }
+      if f > 0 && fl + ft == 0 { break }
+
+      o, w := old[fl:len( old ) - ft], new[fl:len( new ) - ft]
+      at := findHunk( text, o, expected + hint + fl, last )
+      if at < 0 { continue }
+
+      k := make( []string, 0, len( text ) + len( w ) - len( o ) )
+      k  = append( k, text[:at]... )
+      k  = append( k, w... )
+      text = append( k, text[at + len( o ):]... )
+
+      status := HunkApplied
+      offset := at - fl - expected
+      if offset != 0 { status = HunkOffset }
+      if f > 0 { status = HunkFuzz }
+
+      results[n] = HunkResult{ Status: status, Line: at - fl + 1, Offset: offset, Fuzz: f }
+      delta += len( w ) - len( o )
+      hint   = offset
+      last   = at + len( w )
+      break
+    }
+  }
+
+  return strings.Join( text, "" ), results
+}
+
+func hunkSides( h Hunk ) (old, new []string) {
+  for _, l := range h.Lines {
+    if l[0] != '+' { old = append( old, l[1:] ) }
+    if l[0] != '-' { new = append( new, l[1:] ) }
+  }
+
+  return
+}
+
+func contextRun( lines []string, fromEnd bool ) int {
+  n := 0
+  for ; n < len( lines ); n++ {
+    l := lines[n]
+    if fromEnd { l = lines[len( lines ) - 1 - n] }
+    if l[0] != ' ' { break }
+  }
+
+  return n
+}
+
+// findHunk looks for lines in text starting at pos and then alternately
+// after and before it, never before min
+func findHunk( text, lines []string, pos, min int ) int {
+  matches := func( at int ) bool {
+    if at < min || at + len( lines ) > len( text ) { return false }
+    for i, l := range lines {
+      if text[at + i] != l { return false }
+    }
+    return true
+  }
+
+  for d := 0; pos - d >= min || pos + d <= len( text ); d++ {
+    if matches( pos + d ) { return pos + d }
+    if d > 0 && matches( pos - d ) { return pos - d }
+  }
+
+  return -1
+}
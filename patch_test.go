@@ -0,0 +1,118 @@
+package txt
+
+import (
+  "errors"
+  "testing"
+)
+
+func TestParsePatch( t *testing.T ){
+  const diff = "diff --git a/x b/x\n--- a/x\t2024-01-01\n+++ b/x\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n@@ -9 +9,0 @@\n-z\n"
+
+  patches, err := ParsePatch( diff )
+  if err != nil || len( patches ) != 1 {
+    t.Fatalf( "ParsePatch( %q ) \nreturn   %v, %v", diff, patches, err )
+  }
+
+  p := patches[0]
+  if p.OldName != "a/x" || p.NewName != "b/x" || len( p.Hunks ) != 2 {
+    t.Fatalf( "ParsePatch( %q ) \nreturn   %+v", diff, p )
+  }
+
+  h := p.Hunks[0]
+  if h.OldStart != 1 || h.OldLines != 2 || h.NewStart != 1 || h.NewLines != 2 || !cmpStringArray( h.Lines, []string{ " a\n", "-b", "+c\n" } ) {
+    t.Errorf( "ParsePatch( %q ).Hunks[0] \nreturn   %+v", diff, h )
+  }
+
+  h = p.Hunks[1]
+  if h.OldStart != 9 || h.OldLines != 1 || h.NewStart != 9 || h.NewLines != 0 || !cmpStringArray( h.Lines, []string{ "-z\n" } ) {
+    t.Errorf( "ParsePatch( %q ).Hunks[1] \nreturn   %+v", diff, h )
+  }
+}
+
+func TestParsePatchErrors( t *testing.T ){
+  data := []struct{
+    input    string
+    line     int
+  } {
+    { "@@ -1,2 +1,2 @@\n a\n", 3 },
+    { "@@ -1 +1 @@\n?a\n", 2 },
+    { "@@ -x +1 @@\n", 1 },
+    { "--- a\n+++ b\n@@ -1 +1\n", 3 },
+  }
+
+  for _, d := range data {
+    _, err := ParsePatch( d.input )
+    pe, ok := err.(*PatchError)
+    if !ok || pe.Line != d.line || !errors.Is( err, ErrMalformedPatch ) {
+      t.Errorf( "ParsePatch( %q ) \nreturn   %v\nexpected error at line %d", d.input, err, d.line )
+    }
+  }
+}
+
+func TestApplyPatch( t *testing.T ){
+  const old = "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+  const new = "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\neleven\n12\n"
+
+  patches, err := ParsePatch( UnifiedDiff( old, new, DefaultDiffOptions ) )
+  if err != nil || len( patches ) != 1 {
+    t.Fatalf( "ParsePatch( UnifiedDiff() ) \nreturn   %v, %v", patches, err )
+  }
+  p := patches[0]
+
+  data := []struct{
+    input    string
+    fuzz     int
+    output   string
+    results  []HunkResult
+  } {
+    { old, 0, new, []HunkResult{ { HunkApplied, 1, 0, 0 }, { HunkApplied, 8, 0, 0 } } },
+    { "0\n" + old, 0, "0\n" + new, []HunkResult{ { HunkOffset, 2, 1, 0 }, { HunkOffset, 9, 1, 0 } } },
+    { old[4:], 0, "3\n4\n5\n6\n7\n8\n9\n10\neleven\n12\n", []HunkResult{ { HunkRejected, 0, 0, 0 }, { HunkOffset, 6, -2, 0 } } },
+    { "x\n" + old[2:], 0, "x\n2\n3\n4\n5\n6\n7\n8\n9\n10\neleven\n12\n", []HunkResult{ { HunkRejected, 0, 0, 0 }, { HunkApplied, 8, 0, 0 } } },
+    { "x\n" + old[2:], 1, "x\n" + new[2:], []HunkResult{ { HunkFuzz, 1, 0, 1 }, { HunkApplied, 8, 0, 0 } } },
+    { old[:len( old ) - 3] + "x\n", 1, new[:len( new ) - 3] + "x\n", []HunkResult{ { HunkApplied, 1, 0, 0 }, { HunkFuzz, 8, 0, 1 } } },
+  }
+
+  for _, d := range data {
+    output, results := ApplyPatch( d.input, p, d.fuzz )
+    if output != d.output || !cmpHunkResults( results, d.results ) {
+      t.Errorf( "ApplyPatch( %q, %d ) \nreturn   %q %v\nexpected %q %v", d.input, d.fuzz, output, results, d.output, d.results )
+    }
+  }
+}
+
+func TestApplyPatchNoNewline( t *testing.T ){
+  data := []struct{
+    a, b     string
+  } {
+    { "a\nb", "a\nb\n" },
+    { "a\nb\n", "a\nc" },
+    { "", "a\nb" },
+    { "a\nb\n", "" },
+    { "a\nb", "a\nb\r" },
+    { "a\r\nb\r", "a\r\nc\r" },
+  }
+
+  for _, d := range data {
+    patches, err := ParsePatch( UnifiedDiff( d.a, d.b, DefaultDiffOptions ) )
+    if err != nil || len( patches ) != 1 {
+      t.Errorf( "ParsePatch( UnifiedDiff( %q, %q ) ) \nreturn   %v, %v", d.a, d.b, patches, err )
+      continue
+    }
+
+    output, results := ApplyPatch( d.a, patches[0], 0 )
+    if output != d.b || results[0].Status != HunkApplied {
+      t.Errorf( "ApplyPatch( %q, UnifiedDiff( %q, %q ) ) \nreturn   %q %v\nexpected %q", d.a, d.a, d.b, output, results, d.b )
+    }
+  }
+}
+
+func cmpHunkResults( a, b []HunkResult ) bool {
+  if len( a ) != len( b ) { return false }
+
+  for i, r := range a {
+    if r != b[i] { return false }
+  }
+
+  return true
+}
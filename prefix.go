@@ -0,0 +1,96 @@
+package txt
+
+import "strings"
+
+// CountPrefixDepth reports how many times prefix opens line after its
+// indentation and the width of the indentation and prefixes. The prefix
+// without its trailing spaces also counts when followed by the end of the
+// line, a space or another prefix, so "#+title" is not a comment for "# ".
+// The quote mark ">" counts anywhere, so ">>", "> >" and ">text" are
+// quotes for "> " as email and Markdown take them
+func CountPrefixDepth( line, prefix string ) (depth, width int) {
+  return countPrefixDepth( line, prefix, false )
+}
+
+// CountStrictPrefixDepth works as CountPrefixDepth but applies the rule
+// of the other prefixes to ">" too, so ">text" is not a quote for "> "
+func CountStrictPrefixDepth( line, prefix string ) (depth, width int) {
+  return countPrefixDepth( line, prefix, true )
+}
+
+func countPrefixDepth( line, prefix string, strict bool ) (depth, width int) {
+  mark := strings.TrimRight( prefix, " \t" )
+  if mark == "" { return 0, 0 }
+
+  for i := CountIndentSpaces( line ); ; depth++ {
+    rest := line[i:]
+
+    switch {
+    case strings.HasPrefix( rest, prefix ):
+      i += len( prefix )
+    case strings.HasPrefix( rest, mark ) && ((!strict && mark == ">") || isPrefixEnd( rest[len( mark ):], mark )):
+      i += len( mark )
+    default:
+      return
+    }
+
+    width = i
+  }
+}
+
+func isPrefixEnd( rest, mark string ) bool {
+  if rest == "" || strings.HasPrefix( rest, mark ) { return true }
+
+  switch rest[0] {
+  case ' ', '\t', '\n', '\v', '\f', '\r' : return true
+  }
+
+  return false
+}
+
+func DragTextByPrefix( str, prefix string ) (string, int) {
+  return dragTextByPrefix( str, prefix, 1, false )
+}
+
+// DragTextByPrefixDepth takes the lines at the start of str quoted with
+// prefix at least depth times, removing depth levels of quotes from them
+func DragTextByPrefixDepth( str, prefix string, depth int ) (string, int) {
+  return dragTextByPrefix( str, prefix, depth, false )
+}
+
+// DragTextByStrictPrefixDepth works as DragTextByPrefixDepth with the
+// prefixes counted by CountStrictPrefixDepth
+func DragTextByStrictPrefixDepth( str, prefix string, depth int ) (string, int) {
+  return dragTextByPrefix( str, prefix, depth, true )
+}
+
+func dragTextByPrefix( str, prefix string, depth int, strict bool ) (string, int) {
+  if depth < 1 { depth = 1 }
+
+  k, init := make( []byte, 0, len( str ) ), 0
+  for init < len( str ) {
+    line, width := GetLine( str[init:] )
+    if n, _ := countPrefixDepth( line, prefix, strict ); n < depth { break }
+
+    k = append( k, stripPrefix( line, prefix, depth )... )
+    if width > len( line ) { k = append( k, '\n' ) }
+    init += width
+  }
+
+  return string( k ), init
+}
+
+func stripPrefix( line, prefix string, depth int ) string {
+  mark := strings.TrimRight( prefix, " \t" )
+
+  i := CountIndentSpaces( line )
+  for ; depth > 0; depth-- {
+    if strings.HasPrefix( line[i:], prefix ) {
+      i += len( prefix )
+    } else {
+      i += len( mark )
+    }
+  }
+
+  return line[i:]
+}
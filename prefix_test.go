@@ -0,0 +1,111 @@
+package txt
+
+import "testing"
+
+func TestCountPrefixDepth( t *testing.T ){
+  data := []struct{
+    input    string
+    prefix   string
+    depth    int
+    width    int
+  } {
+    { "", "> ", 0, 0 },
+    { "text", "> ", 0, 0 },
+    { "> text", "> ", 1, 2 },
+    { ">", "> ", 1, 1 },
+    { ">text", "> ", 1, 1 },
+    { ">>text", "> ", 2, 2 },
+    { ">text", ">", 1, 1 },
+    { ">> text", "> ", 2, 3 },
+    { "> > text", "> ", 2, 4 },
+    { ">>>", "> ", 3, 3 },
+    { "  // comment", "// ", 1, 5 },
+    { "//// comment", "// ", 2, 5 },
+    { "#+title", "# ", 0, 0 },
+    { "| a | b", "| ", 1, 2 },
+    { "text", "", 0, 0 },
+  }
+
+  for _, d := range data {
+    depth, width := CountPrefixDepth( d.input, d.prefix )
+    if depth != d.depth || width != d.width {
+      t.Errorf( "CountPrefixDepth( %q, %q ) \nreturn   %d, %d\nexpected %d, %d", d.input, d.prefix, depth, width, d.depth, d.width )
+    }
+  }
+}
+
+func TestDragTextByPrefix( t *testing.T ){
+  data := []struct{
+    input    string
+    prefix   string
+    output   string
+    n        int
+  } {
+    { "", "> ", "", 0 },
+    { "hola", "> ", "", 0 },
+    { "> hola", "> ", "hola", 6 },
+    { "> hola\n> hi\nhoy", "> ", "hola\nhi\n", 12 },
+    { "> hola\n>\n> hi\n\n> hoy", "> ", "hola\n\nhi\n", 14 },
+    { "> a\n>> b\n> > c\n> d", "> ", "a\n> b\n> c\nd", 18 },
+    { "  // uno\n  // dos\n  x := 1", "// ", "uno\ndos\n", 18 },
+    { "# uno\n#\n# dos\n#+title", "# ", "uno\n\ndos\n", 14 },
+    { ">quoted\n>more\n", "> ", "quoted\nmore\n", 14 },
+    { "> a\n>b\nc", "> ", "a\nb\n", 7 },
+  }
+
+  for _, d := range data {
+    output, n := DragTextByPrefix( d.input, d.prefix )
+    if output != d.output || n != d.n {
+      t.Errorf( "DragTextByPrefix( %q, %q ) \nreturn   [%d] %q\nexpected [%d] %q", d.input, d.prefix, n, output, d.n, d.output )
+    }
+  }
+}
+
+func TestStrictPrefix( t *testing.T ){
+  data := []struct{
+    input    string
+    prefix   string
+    depth    int
+    width    int
+  } {
+    { ">text", "> ", 0, 0 },
+    { "> text", "> ", 1, 2 },
+    { ">", "> ", 1, 1 },
+    { ">> text", "> ", 2, 3 },
+    { "#+title", "# ", 0, 0 },
+    { "# title", "# ", 1, 2 },
+  }
+
+  for _, d := range data {
+    depth, width := CountStrictPrefixDepth( d.input, d.prefix )
+    if depth != d.depth || width != d.width {
+      t.Errorf( "CountStrictPrefixDepth( %q, %q ) \nreturn   %d, %d\nexpected %d, %d", d.input, d.prefix, depth, width, d.depth, d.width )
+    }
+  }
+
+  const doc = "# uno\n#\n# dos\n#+title"
+  if output, n := DragTextByStrictPrefixDepth( doc, "# ", 1 ); output != "uno\n\ndos\n" || n != 14 {
+    t.Errorf( "DragTextByStrictPrefixDepth( %q, %q, 1 ) \nreturn   [%d] %q\nexpected [14] %q", doc, "# ", n, output, "uno\n\ndos\n" )
+  }
+}
+
+func TestDragTextByPrefixDepth( t *testing.T ){
+  data := []struct{
+    input    string
+    depth    int
+    output   string
+    n        int
+  } {
+    { "> a\n>> b", 2, "", 0 },
+    { ">> b\n> > c\n> d", 2, "b\nc\n", 11 },
+    { ">>> b\n>> c\n", 2, "> b\nc\n", 11 },
+    { ">> b\n", 0, "> b\n", 5 },
+  }
+
+  for _, d := range data {
+    output, n := DragTextByPrefixDepth( d.input, "> ", d.depth )
+    if output != d.output || n != d.n {
+      t.Errorf( "DragTextByPrefixDepth( %q, %q, %d ) \nreturn   [%d] %q\nexpected [%d] %q", d.input, "> ", d.depth, n, output, d.n, d.output )
+    }
+  }
+}
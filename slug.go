@@ -0,0 +1,129 @@
+package txt
+
+import (
+  "unicode"
+  "unicode/utf8"
+)
+
+// SlugOptions sets the Separator, "-" when empty, written between the words
+// of a slug and its MaxLength in bytes, no limit when zero
+type SlugOptions struct {
+  Separator string
+  MaxLength int
+}
+
+// Slugify lowercases str, transliterates Latin, Greek and Cyrillic letters
+// to ASCII and replaces every run of other runes with the separator, e.g.
+// "¿Qué es el Ñandú?" gives "que-es-el-nandu". Apostrophes and combining
+// marks, as in decomposed accents, are dropped without separating, and
+// letters of other scripts are kept lowercased with the marks that follow
+// them, as the vowel signs of "नमस्ते". Over MaxLength the slug is
+// cut at the last separator that fits, or inside its first word when none
+// does.
+func Slugify( str string, opt SlugOptions ) string {
+  sep := opt.Separator
+  if sep == "" { sep = "-" }
+
+  k := make( []byte, 0, len( str ) )
+  pending, marks := false, false
+
+  for _, c := range str {
+    if isCombiningMark( c ) {
+      if marks { k = append( k, string( c )... ) }
+      continue
+    }
+    if c == '\'' || c == '’' { continue }
+
+    c = unicode.ToLower( c )
+    t, ok := slugTable[c]
+    switch {
+    case ok:
+    case c < utf8.RuneSelf && ('a' <= c && c <= 'z' || '0' <= c && c <= '9'):
+      t = string( c )
+    case c >= utf8.RuneSelf && isWordRune( c ):
+      t = string( c )
+    default:
+      pending, marks = len( k ) > 0, false
+      continue
+    }
+
+    if pending { k = append( k, sep... ) }
+    k = append( k, t... )
+    pending, marks = false, !ok && c >= utf8.RuneSelf
+  }
+
+  if opt.MaxLength > 0 && len( k ) > opt.MaxLength { k = cutSlug( k, sep, opt.MaxLength ) }
+
+  return string( k )
+}
+
+func cutSlug( k []byte, sep string, max int ) []byte {
+  // a separator starting at max ends a word that fits
+  for i := max; i > 0; i-- {
+    if i + len( sep ) > len( k ) { continue }
+    if string( k[i:i + len( sep )] ) == sep { return k[:i] }
+  }
+
+  for max > 0 && !utf8.RuneStart( k[max] ) { max-- }
+
+  return k[:max]
+}
+
+var slugTable = map[rune]string{
+  'ß': "ss", 'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a",
+  'æ': "ae", 'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i",
+  'í': "i", 'î': "i", 'ï': "i", 'ð': "d", 'ñ': "n", 'ò': "o", 'ó': "o",
+  'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ù': "u", 'ú': "u", 'û': "u",
+  'ü': "u", 'ý': "y", 'þ': "th", 'ÿ': "y", 'ā': "a", 'ă': "a", 'ą': "a",
+  'ć': "c", 'ĉ': "c", 'ċ': "c", 'č': "c", 'ď': "d", 'đ': "d", 'ē': "e",
+  'ĕ': "e", 'ė': "e", 'ę': "e", 'ě': "e", 'ĝ': "g", 'ğ': "g", 'ġ': "g",
+  'ģ': "g", 'ĥ': "h", 'ħ': "h", 'ĩ': "i", 'ī': "i", 'ĭ': "i", 'į': "i",
+  'ı': "i", 'ĳ': "ij", 'ĵ': "j", 'ķ': "k", 'ĸ': "k", 'ĺ': "l", 'ļ': "l",
+  'ľ': "l", 'ŀ': "l", 'ł': "l", 'ń': "n", 'ņ': "n", 'ň': "n", 'ŉ': "n",
+  'ŋ': "ng", 'ō': "o", 'ŏ': "o", 'ő': "o", 'œ': "oe", 'ŕ': "r", 'ŗ': "r",
+  'ř': "r", 'ś': "s", 'ŝ': "s", 'ş': "s", 'š': "s", 'ţ': "t", 'ť': "t",
+  'ŧ': "t", 'ũ': "u", 'ū': "u", 'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+  'ŵ': "w", 'ŷ': "y", 'ź': "z", 'ż': "z", 'ž': "z", 'ſ': "s", 'ƒ': "f",
+  'ơ': "o", 'ư': "u", 'ǆ': "dz", 'ǉ': "lj", 'ǌ': "nj", 'ǎ': "a", 'ǐ': "i",
+  'ǒ': "o", 'ǔ': "u", 'ǖ': "u", 'ǘ': "u", 'ǚ': "u", 'ǜ': "u", 'ǟ': "a",
+  'ǡ': "a", 'ǧ': "g", 'ǩ': "k", 'ǫ': "o", 'ǭ': "o", 'ǰ': "j", 'ǳ': "dz",
+  'ǵ': "g", 'ǹ': "n", 'ǻ': "a", 'ȁ': "a", 'ȃ': "a", 'ȅ': "e", 'ȇ': "e",
+  'ȉ': "i", 'ȋ': "i", 'ȍ': "o", 'ȏ': "o", 'ȑ': "r", 'ȓ': "r", 'ȕ': "u",
+  'ȗ': "u", 'ș': "s", 'ț': "t", 'ȟ': "h", 'ȧ': "a", 'ȩ': "e", 'ȫ': "o",
+  'ȭ': "o", 'ȯ': "o", 'ȱ': "o", 'ȳ': "y", 'ḁ': "a", 'ḃ': "b", 'ḅ': "b",
+  'ḇ': "b", 'ḉ': "c", 'ḋ': "d", 'ḍ': "d", 'ḏ': "d", 'ḑ': "d", 'ḓ': "d",
+  'ḕ': "e", 'ḗ': "e", 'ḙ': "e", 'ḛ': "e", 'ḝ': "e", 'ḟ': "f", 'ḡ': "g",
+  'ḣ': "h", 'ḥ': "h", 'ḧ': "h", 'ḩ': "h", 'ḫ': "h", 'ḭ': "i", 'ḯ': "i",
+  'ḱ': "k", 'ḳ': "k", 'ḵ': "k", 'ḷ': "l", 'ḹ': "l", 'ḻ': "l", 'ḽ': "l",
+  'ḿ': "m", 'ṁ': "m", 'ṃ': "m", 'ṅ': "n", 'ṇ': "n", 'ṉ': "n", 'ṋ': "n",
+  'ṍ': "o", 'ṏ': "o", 'ṑ': "o", 'ṓ': "o", 'ṕ': "p", 'ṗ': "p", 'ṙ': "r",
+  'ṛ': "r", 'ṝ': "r", 'ṟ': "r", 'ṡ': "s", 'ṣ': "s", 'ṥ': "s", 'ṧ': "s",
+  'ṩ': "s", 'ṫ': "t", 'ṭ': "t", 'ṯ': "t", 'ṱ': "t", 'ṳ': "u", 'ṵ': "u",
+  'ṷ': "u", 'ṹ': "u", 'ṻ': "u", 'ṽ': "v", 'ṿ': "v", 'ẁ': "w", 'ẃ': "w",
+  'ẅ': "w", 'ẇ': "w", 'ẉ': "w", 'ẋ': "x", 'ẍ': "x", 'ẏ': "y", 'ẑ': "z",
+  'ẓ': "z", 'ẕ': "z", 'ẖ': "h", 'ẗ': "t", 'ẘ': "w", 'ẙ': "y", 'ẛ': "s",
+  'ạ': "a", 'ả': "a", 'ấ': "a", 'ầ': "a", 'ẩ': "a", 'ẫ': "a", 'ậ': "a",
+  'ắ': "a", 'ằ': "a", 'ẳ': "a", 'ẵ': "a", 'ặ': "a", 'ẹ': "e", 'ẻ': "e",
+  'ẽ': "e", 'ế': "e", 'ề': "e", 'ể': "e", 'ễ': "e", 'ệ': "e", 'ỉ': "i",
+  'ị': "i", 'ọ': "o", 'ỏ': "o", 'ố': "o", 'ồ': "o", 'ổ': "o", 'ỗ': "o",
+  'ộ': "o", 'ớ': "o", 'ờ': "o", 'ở': "o", 'ỡ': "o", 'ợ': "o", 'ụ': "u",
+  'ủ': "u", 'ứ': "u", 'ừ': "u", 'ử': "u", 'ữ': "u", 'ự': "u", 'ỳ': "y",
+  'ỵ': "y", 'ỷ': "y", 'ỹ': "y",
+
+  // Greek
+  'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+  'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+  'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+  'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o", 'ά': "a", 'έ': "e", 'ή': "i",
+  'ί': "i", 'ό': "o", 'ύ': "y", 'ώ': "o", 'ϊ': "i", 'ϋ': "y", 'ΐ': "i",
+  'ΰ': "y",
+
+  // Cyrillic
+  'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+  'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+  'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+  'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+  'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i",
+  'ї': "yi", 'ґ': "g", 'ў': "u", 'ђ': "dj", 'ј': "j", 'љ': "lj", 'њ': "nj",
+  'ћ': "c", 'џ': "dz", 'ѓ': "g", 'ќ': "k", 'ѕ': "dz",
+}
@@ -0,0 +1,46 @@
+package txt
+
+import (
+  "testing"
+)
+
+func TestSlugify( t *testing.T ){
+  data := []struct{
+    input    string
+    opt      SlugOptions
+    output   string
+  } {
+    { "", SlugOptions{}, "" },
+    { "Hello World", SlugOptions{}, "hello-world" },
+    { "¿Qué es el Ñandú?", SlugOptions{}, "que-es-el-nandu" },
+    { "  --Año   nuevo,\tvida nueva!!  ", SlugOptions{}, "ano-nuevo-vida-nueva" },
+    { "Straße & Œuvre", SlugOptions{}, "strasse-oeuvre" },
+    { "Łódź, Ærøskøbing", SlugOptions{}, "lodz-aeroskobing" },
+    { "Tiếng Việt", SlugOptions{}, "tieng-viet" },
+    { "Ελληνικά", SlugOptions{}, "ellinika" },
+    { "Щи и борщ", SlugOptions{}, "shchi-i-borshch" },
+    { "Re\u0301sume\u0301 fina\u0301l", SlugOptions{}, "resume-final" },
+    { "cafe\u0301s", SlugOptions{}, "cafes" },
+    { "N\u0303andu\u0301", SlugOptions{}, "nandu" },
+    { "Don't stop", SlugOptions{}, "dont-stop" },
+    { "l’été", SlugOptions{}, "lete" },
+    { "Chapter 10.2", SlugOptions{}, "chapter-10-2" },
+    { "日本語 text", SlugOptions{}, "日本語-text" },
+    { "नमस्ते दुनिया", SlugOptions{}, "नमस्ते-दुनिया" },
+    { "Ба\u0301бушка", SlugOptions{}, "babushka" },
+    { "Hello World", SlugOptions{ Separator: "_" }, "hello_world" },
+    { "uno dos tres", SlugOptions{ MaxLength: 9 }, "uno-dos" },
+    { "uno dos tres", SlugOptions{ MaxLength: 7 }, "uno-dos" },
+    { "uno dos tres", SlugOptions{ MaxLength: 6 }, "uno" },
+    { "supercalifragilistic", SlugOptions{ MaxLength: 5 }, "super" },
+    { "日本語", SlugOptions{ MaxLength: 5 }, "日" },
+    { "a b", SlugOptions{ Separator: "--", MaxLength: 3 }, "a" },
+  }
+
+  for _, d := range data {
+    output := Slugify( d.input, d.opt )
+    if output != d.output {
+      t.Errorf( "Slugify( %q, %+v ) \nreturn   %q\nexpected %q", d.input, d.opt, output, d.output )
+    }
+  }
+}
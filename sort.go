@@ -0,0 +1,190 @@
+package txt
+
+import (
+  "sort"
+  "strconv"
+  "strings"
+  "unicode"
+)
+
+// NaturalCompare compares a and b as strings except that runs of ASCII
+// digits compare by their numeric value, so "file2" goes before "file10";
+// equal numbers with more leading zeros go after. It returns -1, 0 or +1
+// and 0 only when a == b.
+func NaturalCompare( a, b string ) int {
+  zeros := 0
+
+  for len( a ) > 0 && len( b ) > 0 {
+    if isDigit( a[0] ) && isDigit( b[0] ) {
+      da, za := digitRun( a )
+      db, zb := digitRun( b )
+
+      if c := cmpDigits( a[za:da], b[zb:db] ); c != 0 { return c }
+      if zeros == 0 && za != zb {
+        zeros = 1
+        if za < zb { zeros = -1 }
+      }
+
+      a, b = a[da:], b[db:]
+      continue
+    }
+
+    // bytes of UTF-8 sort in the order of their runes
+    if a[0] != b[0] {
+      if a[0] < b[0] { return -1 }
+      return 1
+    }
+    a, b = a[1:], b[1:]
+  }
+
+  switch {
+  case len( a ) < len( b ): return -1
+  case len( a ) > len( b ): return 1
+  }
+
+  return zeros
+}
+
+func NaturalLess( a, b string ) bool {
+  return NaturalCompare( a, b ) < 0
+}
+
+func isDigit( c byte ) bool { return '0' <= c && c <= '9' }
+
+// digitRun returns the length of the digit run starting str and how many
+// of its digits are leading zeros, the last digit never counted as one
+func digitRun( str string ) (n, zeros int) {
+  for n < len( str ) && isDigit( str[n] ) { n++ }
+  for zeros < n - 1 && str[zeros] == '0' { zeros++ }
+
+  return
+}
+
+func cmpDigits( a, b string ) int {
+  switch {
+  case len( a ) < len( b ): return -1
+  case len( a ) > len( b ): return 1
+  }
+
+  return strings.Compare( a, b )
+}
+
+// SortOptions sets how SortLines orders lines and how Compare compares
+// them. Lines compare in natural order by default, by the value of their
+// leading number with Numeric as sort -n does, and only by field Key,
+// counted from 1, when it is set, as sort -k Key,Key does.
+type SortOptions struct {
+  Reverse       bool
+  Unique        bool   // keep only the first of the lines comparing equal
+  Numeric       bool
+  FoldCase      bool
+  IgnoreAccents bool
+  Key           int    // field used as key, the whole line when zero
+  Separator     string // field separator, runs of spaces when empty
+}
+
+// Compare compares a and b under the options, Reverse included
+func (o SortOptions) Compare( a, b string ) int {
+  c := o.compareKeys( o.key( a ), o.key( b ) )
+  if o.Reverse { return -c }
+
+  return c
+}
+
+func (o SortOptions) compareKeys( a, b string ) int {
+  if o.Numeric {
+    na, nb := leadingNumber( a ), leadingNumber( b )
+    switch {
+    case na < nb: return -1
+    case na > nb: return 1
+    }
+    return 0
+  }
+
+  return NaturalCompare( a, b )
+}
+
+func (o SortOptions) key( line string ) string {
+  if o.Key > 0 {
+    var fields []string
+    if o.Separator == "" {
+      fields = Tokenize( line )
+    } else {
+      fields = strings.Split( line, o.Separator )
+    }
+
+    line = ""
+    if o.Key <= len( fields ) { line = fields[o.Key - 1] }
+  }
+
+  if o.IgnoreAccents { line = stripAccents( line ) }
+  if o.FoldCase      { line = FoldCase( line ) }
+
+  return line
+}
+
+func stripAccents( str string ) string {
+  d := NFD.String( str )
+  k := make( []byte, 0, len( d ) )
+  for _, c := range d {
+    if unicode.Is( unicode.Mn, c ) { continue }
+    k = append( k, string( c )... )
+  }
+
+  return NFC.String( string( k ) )
+}
+
+// leadingNumber is the value of the number, with optional sign and decimal
+// part, that begins str after spaces, 0 when there is none
+func leadingNumber( str string ) float64 {
+  str = RmSpacesAtStartup( str )
+
+  n := 0
+  if n < len( str ) && (str[n] == '-' || str[n] == '+') { n++ }
+  for n < len( str ) && isDigit( str[n] ) { n++ }
+  if n < len( str ) && str[n] == '.' {
+    n++
+    for n < len( str ) && isDigit( str[n] ) { n++ }
+  }
+
+  v, err := strconv.ParseFloat( str[:n], 64 )
+  if err != nil { return 0 }
+
+  return v
+}
+
+// SortLines sorts the lines of str, as split by GetLines, keeping the order
+// of lines comparing equal. Lines end in a newline when str does.
+func SortLines( str string, opt SortOptions ) string {
+  lines := GetLines( str )
+  keys  := make( []string, len( lines ) )
+  for i, l := range lines {
+    keys[i] = opt.key( l )
+  }
+
+  idx := make( []int, len( lines ) )
+  for i := range idx {
+    idx[i] = i
+  }
+
+  cmp := func( i, j int ) int {
+    c := opt.compareKeys( keys[i], keys[j] )
+    if opt.Reverse { return -c }
+    return c
+  }
+  sort.SliceStable( idx, func( i, j int ) bool { return cmp( idx[i], idx[j] ) < 0 } )
+
+  r := make( []string, 0, len( lines ) )
+  for n, i := range idx {
+    if opt.Unique && n > 0 && cmp( idx[n - 1], i ) == 0 { continue }
+    r = append( r, lines[i] )
+  }
+
+  return joinLines( r, strings.HasSuffix( str, "\n" ) )
+}
+
+func joinLines( lines []string, newline bool ) string {
+  if newline && len( lines ) > 0 { return strings.Join( lines, "\n" ) + "\n" }
+
+  return strings.Join( lines, "\n" )
+}
@@ -0,0 +1,76 @@
+package txt
+
+import (
+  "sort"
+  "testing"
+)
+
+func TestNaturalCompare( t *testing.T ){
+  data := []struct{
+    a, b     string
+    output   int
+  } {
+    { "", "", 0 },
+    { "", "a", -1 },
+    { "file2", "file10", -1 },
+    { "file10", "file2", 1 },
+    { "file10", "file10", 0 },
+    { "file010", "file9", 1 },
+    { "file01", "file1", 1 },
+    { "file1", "file01", -1 },
+    { "a1b2", "a1b10", -1 },
+    { "1.9", "1.10", -1 },
+    { "x0", "x00", -1 },
+    { "file", "file1", -1 },
+    { "ñandú2", "ñandú10", -1 },
+    { "a", "b", -1 },
+    { "Z", "a", -1 },
+    { "18446744073709551616", "18446744073709551617", -1 },
+  }
+
+  for _, d := range data {
+    output := NaturalCompare( d.a, d.b )
+    if output != d.output {
+      t.Errorf( "NaturalCompare( %q, %q ) \nreturn   %d\nexpected %d", d.a, d.b, output, d.output )
+    }
+  }
+
+  input    := []string{ "img12.png", "img10.png", "IMG2.png", "img2.png", "img1.png" }
+  expected := []string{ "IMG2.png", "img1.png", "img2.png", "img10.png", "img12.png" }
+  sort.Slice( input, func( i, j int ) bool { return NaturalLess( input[i], input[j] ) } )
+  if !cmpStringArray( input, expected ) {
+    t.Errorf( "sort with NaturalLess \nreturn   %q\nexpected %q", input, expected )
+  }
+}
+
+func TestSortLines( t *testing.T ){
+  data := []struct{
+    input    string
+    opt      SortOptions
+    output   string
+  } {
+    { "", SortOptions{}, "" },
+    { "b\na\n", SortOptions{}, "a\nb\n" },
+    { "b\na", SortOptions{}, "a\nb" },
+    { "file10\nfile2\nfile1\n", SortOptions{}, "file1\nfile2\nfile10\n" },
+    { "file10\nfile2\nfile1\n", SortOptions{ Reverse: true }, "file10\nfile2\nfile1\n" },
+    { "b\na\nb\na\n", SortOptions{ Unique: true }, "a\nb\n" },
+    { "b\nB\na\n", SortOptions{ FoldCase: true }, "a\nb\nB\n" },
+    { "b\nB\na\n", SortOptions{ FoldCase: true, Unique: true }, "a\nb\n" },
+    { "éclair\nezine\nedad\n", SortOptions{}, "edad\nezine\néclair\n" },
+    { "éclair\nezine\nedad\n", SortOptions{ IgnoreAccents: true }, "éclair\nedad\nezine\n" },
+    { "Ñu\nnube\nÑandú\n", SortOptions{ IgnoreAccents: true, FoldCase: true }, "Ñandú\nÑu\nnube\n" },
+    { "10\n-2.5\n3\nx\n", SortOptions{ Numeric: true }, "-2.5\nx\n3\n10\n" },
+    { "1.0\n1\n", SortOptions{ Numeric: true, Unique: true }, "1.0\n" },
+    { "c 3\na 10\nb 2\n", SortOptions{ Key: 2 }, "b 2\nc 3\na 10\n" },
+    { "c 3\na 10\nb\n", SortOptions{ Key: 2, Numeric: true }, "b\nc 3\na 10\n" },
+    { "c:x:3\na:y:10\nb:z:2\n", SortOptions{ Key: 3, Separator: ":" }, "b:z:2\nc:x:3\na:y:10\n" },
+  }
+
+  for _, d := range data {
+    output := SortLines( d.input, d.opt )
+    if output != d.output {
+      t.Errorf( "SortLines( %q, %+v ) \nreturn   %q\nexpected %q", d.input, d.opt, output, d.output )
+    }
+  }
+}
@@ -0,0 +1,262 @@
+package txt
+
+import (
+  "errors"
+  "strconv"
+  "strings"
+  "unicode/utf8"
+)
+
+var (
+  ErrUnterminatedQuote  = errors.New( "txt: unterminated quote" )
+  ErrUnterminatedEscape = errors.New( "txt: unterminated escape" )
+)
+
+type TokenError struct {
+  Offset int
+  Err    error
+}
+
+func (e *TokenError) Error() string {
+  return e.Err.Error() + " at offset " + strconv.Itoa( e.Offset )
+}
+
+func (e *TokenError) Unwrap() error { return e.Err }
+
+// Token is a token with its byte span [Start, End) in the tokenized string
+// and the line and byte column, both starting at 1, where it begins
+type Token struct {
+  Text       string
+  Start, End int
+  Line, Col  int
+}
+
+func TokenizeSpans( str string ) []Token {
+  r   := make( []Token, 0, 16 )
+  pos := tokenPos{ line: 1, col: 1 }
+
+  i, w, max := 0, 0, len( str )
+  for i < max {
+    i += CountInitSpaces( str[i:] )
+    w  = CountInitChars ( str[i:] )
+
+    if w > 0 {
+      r = append( r, pos.token( str, str[i:i+w], i, i + w ) )
+      i += w
+    } else { break }
+  }
+
+  return r
+}
+
+type tokenPos struct {
+  last, line, col int
+}
+
+func (p *tokenPos) token( str, text string, start, end int ) Token {
+  for ; p.last < start; p.last++ {
+    if str[p.last] == '\n' {
+      p.line++
+      p.col = 1
+    } else {
+      p.col++
+    }
+  }
+
+  return Token{ Text: text, Start: start, End: end, Line: p.line, Col: p.col }
+}
+
+// Tokenizer splits text into tokens with shell-like rules. A single quote
+// keeps everything up to the next single quote literally, any other quote
+// rune accepts backslash escapes of itself and of the backslash when Escape
+// is set. Quoted and unquoted parts not separated by a delimiter form one
+// token, and an empty pair of quotes yields an empty token.
+type Tokenizer struct {
+  Delims string // delimiter runes, the space set of CountInitSpaces when empty
+  Quotes string // quote runes, e.g. "\"'"
+  Escape bool   // backslash escapes the next rune outside quotes
+  Form   Form   // normalization of the token text, none when zero
+}
+
+var ShellTokenizer = Tokenizer{ Quotes: "\"'", Escape: true }
+
+func TokenizeQuoted( str string ) ([]string, error) {
+  return ShellTokenizer.Tokenize( str )
+}
+
+func Join( tokens []string ) string {
+  return ShellTokenizer.Join( tokens )
+}
+
+func (t Tokenizer) Tokenize( str string ) ([]string, error) {
+  spans, err := t.TokenizeSpans( str )
+  if err != nil { return nil, err }
+
+  r := make( []string, len( spans ) )
+  for i, s := range spans {
+    r[i] = s.Text
+  }
+
+  return r, nil
+}
+
+// TokenizeSpans works as Tokenize, each Token carrying its unquoted text and
+// the span of its raw form in str
+func (t Tokenizer) TokenizeSpans( str string ) ([]Token, error) {
+  r   := make( []Token, 0, 16 )
+  tok := make( []byte, 0, 64 )
+  in, start := false, 0
+  pos := tokenPos{ line: 1, col: 1 }
+
+  for i := 0; i < len( str ); {
+    c, w := utf8.DecodeRuneInString( str[i:] )
+
+    if !in && !t.isDelim( c ) { start = i }
+
+    switch {
+    case t.Escape && c == '\\':
+      if i + w >= len( str ) { return nil, &TokenError{ i, ErrUnterminatedEscape } }
+      _, n := utf8.DecodeRuneInString( str[i + w:] )
+      tok = append( tok, str[i + w:i + w + n]... )
+      in  = true
+      i  += w + n
+    case t.isQuote( c ):
+      body, n, ok := t.unquote( str[i:], c )
+      if !ok { return nil, &TokenError{ i, ErrUnterminatedQuote } }
+      tok = append( tok, body... )
+      in  = true
+      i  += n
+    case t.isDelim( c ):
+      if in {
+        r   = append( r, pos.token( str, t.Form.String( string( tok ) ), start, i ) )
+        tok = tok[:0]
+        in  = false
+      }
+      i += w
+    default:
+      tok = append( tok, str[i:i + w]... )
+      in  = true
+      i  += w
+    }
+  }
+
+  if in { r = append( r, pos.token( str, t.Form.String( string( tok ) ), start, len( str ) ) ) }
+
+  return r, nil
+}
+
+func (t Tokenizer) unquote( str string, q rune ) ([]byte, int, bool) {
+  k := make( []byte, 0, len( str ) )
+  w := utf8.RuneLen( q )
+  weak := t.Escape && q != '\''
+
+  for i := w; i < len( str ); {
+    c, n := utf8.DecodeRuneInString( str[i:] )
+
+    switch {
+    case c == q:
+      return k, i + n, true
+    case weak && c == '\\' && i + n < len( str ):
+      e, m := utf8.DecodeRuneInString( str[i + n:] )
+      if e == q || e == '\\' {
+        k  = append( k, str[i + n:i + n + m]... )
+        i += n + m
+        continue
+      }
+      fallthrough
+    default:
+      k  = append( k, str[i:i + n]... )
+      i += n
+    }
+  }
+
+  return nil, len( str ), false
+}
+
+// Join writes tokens separated by the first rune of Delims, a space when
+// empty, quoting or escaping the ones Tokenize would split or drop. Without
+// Quotes an empty token is lost, and without Quotes nor Escape a token
+// holding a delimiter is written as is, so those don't round-trip
+func (t Tokenizer) Join( tokens []string ) string {
+  parts := make( []string, len( tokens ) )
+  for i, tok := range tokens {
+    parts[i] = t.quote( tok )
+  }
+
+  sep := " "
+  if t.Delims != "" {
+    c, _ := utf8.DecodeRuneInString( t.Delims )
+    sep   = string( c )
+  }
+
+  return strings.Join( parts, sep )
+}
+
+func (t Tokenizer) quote( tok string ) string {
+  if tok != "" && !t.needsQuote( tok ) { return tok }
+
+  for _, q := range t.Quotes {
+    if strings.ContainsRune( tok, q ) { continue }
+    if t.Escape && q != '\'' {
+      return string( q ) + strings.Replace( tok, `\`, `\\`, -1 ) + string( q )
+    }
+    return string( q ) + tok + string( q )
+  }
+
+  if t.Escape {
+    for _, q := range t.Quotes {
+      if q == '\'' { continue }
+      e := strings.NewReplacer( `\`, `\\`, string( q ), `\` + string( q ) )
+      return string( q ) + e.Replace( tok ) + string( q )
+    }
+
+    k := make( []byte, 0, len( tok ) * 2 )
+    for _, c := range tok {
+      if c == '\\' || t.isDelim( c ) || t.isQuote( c ) { k = append( k, '\\' ) }
+      k = append( k, string( c )... )
+    }
+    return string( k )
+  }
+
+  // without escapes a token holding every quote rune is written as
+  // consecutive quoted segments, each one avoiding its own quote rune
+  k := make( []byte, 0, len( tok ) + 8 )
+  for rest := tok; rest != ""; {
+    best, bq := 0, rune( 0 )
+    for _, q := range t.Quotes {
+      n := strings.IndexRune( rest, q )
+      if n < 0 { n = len( rest ) }
+      if n > best { best, bq = n, q }
+    }
+
+    if best == 0 { return string( k ) + rest }
+
+    k    = append( k, string( bq ) + rest[:best] + string( bq )... )
+    rest = rest[best:]
+  }
+
+  return string( k )
+}
+
+func (t Tokenizer) needsQuote( tok string ) bool {
+  for _, c := range tok {
+    if t.isDelim( c ) || t.isQuote( c ) || (t.Escape && c == '\\') { return true }
+  }
+
+  return false
+}
+
+func (t Tokenizer) isDelim( c rune ) bool {
+  if t.Delims == "" {
+    switch c {
+    case ' ', '\t', '\n', '\v', '\f', '\r' : return true
+    }
+    return false
+  }
+
+  return strings.ContainsRune( t.Delims, c )
+}
+
+func (t Tokenizer) isQuote( c rune ) bool {
+  return strings.ContainsRune( t.Quotes, c )
+}
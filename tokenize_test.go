@@ -0,0 +1,170 @@
+package txt
+
+import (
+  "errors"
+  "testing"
+)
+
+func TestTokenizeQuoted( t *testing.T ){
+  data := []struct{
+    input    string
+    output   []string
+    err      error
+  } {
+    { "", []string{}, nil },
+    { "a b c", []string{ "a", "b", "c" }, nil },
+    { `say "hello world"`, []string{ "say", "hello world" }, nil },
+    { `#+options: title "My Doc"`, []string{ "#+options:", "title", "My Doc" }, nil },
+    { `'a "b" c' d`, []string{ `a "b" c`, "d" }, nil },
+    { `a"b c"d`, []string{ "ab cd" }, nil },
+    { `"" ''`, []string{ "", "" }, nil },
+    { `a\ b c`, []string{ "a b", "c" }, nil },
+    { `"a \"b\" \\ \n"`, []string{ `a "b" \ \n` }, nil },
+    { `'a \' b`, []string{ `a \`, "b" }, nil },
+    { " \n\t hola,\n que\t\v tal! ", []string{ "hola,", "que", "tal!" }, nil },
+    { `"–bueno–" es`, []string{ "–bueno–", "es" }, nil },
+    { `say "hello`, nil, ErrUnterminatedQuote },
+    { `'hello`, nil, ErrUnterminatedQuote },
+    { `hello\`, nil, ErrUnterminatedEscape },
+  }
+
+  for _, d := range data {
+    output, err := TokenizeQuoted( d.input )
+    if !errors.Is( err, d.err ) || !cmpStringArray( output, d.output ) {
+      t.Errorf( "TokenizeQuoted( %q ) \nreturn   %q, %v\nexpected %q, %v", d.input, output, err, d.output, d.err )
+    }
+  }
+}
+
+func TestTokenizerDelims( t *testing.T ){
+  data := []struct{
+    tokenizer Tokenizer
+    input     string
+    output    []string
+  } {
+    { Tokenizer{ Delims: "," }, "a,b c,,d", []string{ "a", "b c", "d" } },
+    { Tokenizer{ Delims: ",;", Quotes: `"` }, `a;"b,c";d`, []string{ "a", "b,c", "d" } },
+    { Tokenizer{ Delims: "|", Quotes: `'`, Escape: true }, `a\|b|'c\|d'`, []string{ "a|b", `c\|d` } },
+    { Tokenizer{ Delims: "→" }, "uno→dos→→tres", []string{ "uno", "dos", "tres" } },
+  }
+
+  for _, d := range data {
+    output, err := d.tokenizer.Tokenize( d.input )
+    if err != nil || !cmpStringArray( output, d.output ) {
+      t.Errorf( "%+v.Tokenize( %q ) \nreturn   %q, %v\nexpected %q", d.tokenizer, d.input, output, err, d.output )
+    }
+  }
+}
+
+func TestTokenErrorOffset( t *testing.T ){
+  _, err := TokenizeQuoted( `a b "c d` )
+  te, ok := err.(*TokenError)
+  if !ok || te.Offset != 4 {
+    t.Errorf( "TokenizeQuoted( %q ) \nreturn   %v\nexpected offset 4", `a b "c d`, err )
+  }
+}
+
+func TestJoin( t *testing.T ){
+  data := []struct{
+    input    []string
+    output   string
+  } {
+    { []string{}, "" },
+    { []string{ "a", "b" }, "a b" },
+    { []string{ "say", "hello world" }, `say "hello world"` },
+    { []string{ "" }, `""` },
+    { []string{ `it's` }, `"it's"` },
+    { []string{ `a\b c` }, `"a\\b c"` },
+    { []string{ `"it's"` }, `"\"it's\""` },
+  }
+
+  for _, d := range data {
+    output := Join( d.input )
+    if output != d.output {
+      t.Errorf( "Join( %q ) \nreturn   %q\nexpected %q", d.input, output, d.output )
+    }
+  }
+}
+
+func TestJoinRoundTrip( t *testing.T ){
+  tokenizers := []Tokenizer{
+    ShellTokenizer,
+    { Quotes: `'`, Escape: true },
+    { Escape: true },
+    { Quotes: `"'` },
+    { Delims: ",", Quotes: `"` , Escape: true },
+  }
+
+  data := [][]string{
+    { "a", "b c", "" },
+    { `it's`, `"quoted"`, `back\slash` },
+    { `"it's"`, "tab\there", "new\nline" },
+    { "a,b", "ñandú", "–bueno–" },
+  }
+
+  for _, tk := range tokenizers {
+    for _, d := range data {
+      // an empty token needs quotes
+      if tk.Quotes == "" && cmpStringArray( d, data[0] ) { continue }
+
+      joined := tk.Join( d )
+      output, err := tk.Tokenize( joined )
+      if err != nil || !cmpStringArray( output, d ) {
+        t.Errorf( "%+v.Tokenize( Join( %q ) ) \nreturn   %q, %v\nexpected %q", tk, d, output, err, d )
+      }
+    }
+  }
+
+  // without quotes nor escapes tokens are written as is
+  tk, d := Tokenizer{ Delims: "," }, []string{ "a,b", "", "c" }
+  if output := tk.Join( d ); output != "a,b,,c" {
+    t.Errorf( "%+v.Join( %q ) \nreturn   %q\nexpected %q", tk, d, output, "a,b,,c" )
+  }
+}
+
+func TestTokenizeSpans( t *testing.T ){
+  data := []struct{
+    input    string
+    output   []Token
+  } {
+    { "", []Token{} },
+    { " \n\t ", []Token{} },
+    { "a bc", []Token{ { "a", 0, 1, 1, 1 }, { "bc", 2, 4, 1, 3 } } },
+    { "hola,\n que\n\n  tal!", []Token{ { "hola,", 0, 5, 1, 1 }, { "que", 7, 10, 2, 2 }, { "tal!", 14, 18, 4, 3 } } },
+    { "–bueno–, es", []Token{ { "–bueno–,", 0, 12, 1, 1 }, { "es", 13, 15, 1, 14 } } },
+  }
+
+  for _, d := range data {
+    output := TokenizeSpans( d.input )
+    if !cmpTokenArray( output, d.output ) {
+      t.Errorf( "TokenizeSpans( %q ) \nreturn   %v\nexpected %v", d.input, output, d.output )
+    }
+  }
+}
+
+func TestTokenizerSpans( t *testing.T ){
+  data := []struct{
+    input    string
+    output   []Token
+  } {
+    { `#+options: title "My Doc"`, []Token{ { "#+options:", 0, 10, 1, 1 }, { "title", 11, 16, 1, 12 }, { "My Doc", 17, 25, 1, 18 } } },
+    { "a\n  'b c'd \\ e", []Token{ { "a", 0, 1, 1, 1 }, { "b cd", 4, 10, 2, 3 }, { " e", 11, 14, 2, 10 } } },
+  }
+
+  for _, d := range data {
+    output, err := ShellTokenizer.TokenizeSpans( d.input )
+    if err != nil || !cmpTokenArray( output, d.output ) {
+      t.Errorf( "ShellTokenizer.TokenizeSpans( %q ) \nreturn   %v, %v\nexpected %v", d.input, output, err, d.output )
+    }
+  }
+}
+
+func cmpTokenArray( a, b []Token ) bool {
+  if len( a ) != len( b ) { return false }
+
+  for i, tk := range a {
+    if tk != b[i] { return false }
+  }
+
+  return true
+}
@@ -0,0 +1,122 @@
+package txt
+
+import (
+  "strconv"
+  "strings"
+)
+
+// UniqOptions sets how UniqLines groups equal lines: adjacent ones as uniq
+// does or, with Global, anywhere in the input. Lines compare after skipping
+// SkipFields fields, a field being spaces followed by non spaces as in
+// uniq -f, and with FoldCase after case folding.
+type UniqOptions struct {
+  Global     bool
+  Count      bool // Uniq prefixes lines with their count, as uniq -c
+  Duplicates bool // only lines occurring more than once
+  Unique     bool // only lines occurring once
+  FoldCase   bool
+  SkipFields int
+}
+
+// UniqLine is the first line of a group of equal lines and the size of the
+// group
+type UniqLine struct {
+  Text  string
+  Count int
+}
+
+func UniqLines( lines []string, opt UniqOptions ) []UniqLine {
+  groups := make( []UniqLine, 0, len( lines ) )
+  index  := make( map[string]int )
+  last   := ""
+
+  for i, line := range lines {
+    key := opt.key( line )
+
+    switch {
+    case opt.Global:
+      if g, ok := index[key]; ok {
+        groups[g].Count++
+        continue
+      }
+      index[key] = len( groups )
+    case i > 0 && key == last:
+      groups[len( groups ) - 1].Count++
+      continue
+    }
+
+    last   = key
+    groups = append( groups, UniqLine{ line, 1 } )
+  }
+
+  r := groups[:0]
+  for _, g := range groups {
+    if opt.Duplicates && g.Count == 1 { continue }
+    if opt.Unique     && g.Count > 1  { continue }
+    r = append( r, g )
+  }
+
+  return r
+}
+
+func (o UniqOptions) key( line string ) string {
+  for n := 0; n < o.SkipFields; n++ {
+    i   := CountInitSpaces( line )
+    line = line[i + CountInitChars( line[i:] ):]
+  }
+
+  if o.FoldCase { return FoldCase( line ) }
+
+  return line
+}
+
+// Uniq applies UniqLines to the lines of str, as split by GetLines. Lines
+// end in a newline when str does.
+func Uniq( str string, opt UniqOptions ) string {
+  groups := UniqLines( GetLines( str ), opt )
+
+  r := make( []string, len( groups ) )
+  for i, g := range groups {
+    r[i] = g.Text
+    if opt.Count {
+      n := strconv.Itoa( g.Count )
+      for len( n ) < 7 { n = " " + n }
+      r[i] = n + " " + g.Text
+    }
+  }
+
+  return joinLines( r, strings.HasSuffix( str, "\n" ) )
+}
+
+// Comm compares the lines of a and b, as split by GetLines, as multisets:
+// a line in a more times than in b has its extra occurrences in onlyA.
+// Unlike comm the input need not be sorted; every result keeps the order of
+// the text it comes from, both that of a.
+func Comm( a, b string ) (onlyA, onlyB, both []string) {
+  la, lb := GetLines( a ), GetLines( b )
+
+  inB := make( map[string]int, len( lb ) )
+  for _, l := range lb {
+    inB[l]++
+  }
+
+  onlyA, both = make( []string, 0 ), make( []string, 0 )
+  for _, l := range la {
+    if inB[l] > 0 {
+      inB[l]--
+      both = append( both, l )
+    } else {
+      onlyA = append( onlyA, l )
+    }
+  }
+
+  onlyB = make( []string, 0 )
+  for _, l := range lb {
+    if inB[l] > 0 {
+      inB[l]--
+      onlyB = append( onlyB, l )
+    }
+  }
+
+  return
+}
@@ -0,0 +1,52 @@
+package txt
+
+import (
+  "testing"
+)
+
+func TestUniq( t *testing.T ){
+  data := []struct{
+    input    string
+    opt      UniqOptions
+    output   string
+  } {
+    { "", UniqOptions{}, "" },
+    { "a\na\nb\na\n", UniqOptions{}, "a\nb\na\n" },
+    { "a\na\nb\na", UniqOptions{}, "a\nb\na" },
+    { "a\na\nb\na\n", UniqOptions{ Global: true }, "a\nb\n" },
+    { "a\na\nb\na\n", UniqOptions{ Count: true }, "      2 a\n      1 b\n      1 a\n" },
+    { "a\na\nb\na\n", UniqOptions{ Global: true, Count: true }, "      3 a\n      1 b\n" },
+    { "a\na\nb\nc\nc\n", UniqOptions{ Duplicates: true }, "a\nc\n" },
+    { "a\na\nb\nc\nc\n", UniqOptions{ Unique: true }, "b\n" },
+    { "Hola\nHOLA\nhola\nÑandú\nñANDÚ\n", UniqOptions{ FoldCase: true }, "Hola\nÑandú\n" },
+    { "1 a\n2 a\n3 b\n", UniqOptions{ SkipFields: 1 }, "1 a\n3 b\n" },
+    { "x  1 a\ny 2 a\n", UniqOptions{ SkipFields: 2 }, "x  1 a\n" },
+    { "a\n\n\nb\n", UniqOptions{}, "a\n\nb\n" },
+  }
+
+  for _, d := range data {
+    output := Uniq( d.input, d.opt )
+    if output != d.output {
+      t.Errorf( "Uniq( %q, %+v ) \nreturn   %q\nexpected %q", d.input, d.opt, output, d.output )
+    }
+  }
+}
+
+func TestComm( t *testing.T ){
+  data := []struct{
+    a, b                string
+    onlyA, onlyB, both  []string
+  } {
+    { "", "", []string{}, []string{}, []string{} },
+    { "a\nb\nc\n", "b\nc\nd\n", []string{ "a" }, []string{ "d" }, []string{ "b", "c" } },
+    { "c\na\nb", "b\nx\na\n", []string{ "c" }, []string{ "x" }, []string{ "a", "b" } },
+    { "a\na\nb\n", "a\nb\nb\n", []string{ "a" }, []string{ "b" }, []string{ "a", "b" } },
+  }
+
+  for _, d := range data {
+    onlyA, onlyB, both := Comm( d.a, d.b )
+    if !cmpStringArray( onlyA, d.onlyA ) || !cmpStringArray( onlyB, d.onlyB ) || !cmpStringArray( both, d.both ) {
+      t.Errorf( "Comm( %q, %q ) \nreturn   %q, %q, %q\nexpected %q, %q, %q", d.a, d.b, onlyA, onlyB, both, d.onlyA, d.onlyB, d.both )
+    }
+  }
+}
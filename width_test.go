@@ -0,0 +1,71 @@
+package txt
+
+import "testing"
+
+func TestRuneWidth( t *testing.T ){
+  data := []struct{
+    input    rune
+    output   int
+  } {
+    { 'a', 1 },
+    { 'ñ', 1 },
+    { '\t', 0 },
+    { 0xad, 1 },
+    { '́', 0 },
+    { '​', 0 },
+    { 'の', 2 },
+    { '語', 2 },
+    { '한', 2 },
+    { 'Ａ', 2 },
+    { '😀', 2 },
+    { '–', 1 },
+  }
+
+  for _, d := range data {
+    output := RuneWidth( d.input )
+    if output != d.output {
+      t.Errorf( "RuneWidth( %q ) \nreturn   %d\nexpected %d", d.input, output, d.output )
+    }
+  }
+}
+
+func TestStringWidth( t *testing.T ){
+  data := []struct{
+    input    string
+    output   int
+  } {
+    { "", 0 },
+    { "hola", 4 },
+    { "café", 4 },
+    { "私はNullam", 10 },
+  }
+
+  for _, d := range data {
+    output := StringWidth( d.input )
+    if output != d.output {
+      t.Errorf( "StringWidth( %q ) \nreturn   %d\nexpected %d", d.input, output, d.output )
+    }
+  }
+}
+
+func TestExpandTabs( t *testing.T ){
+  data := []struct{
+    input    string
+    n        int
+    output   string
+  } {
+    { "", 4, "" },
+    { "\ta", 4, "    a" },
+    { "ab\tc", 4, "ab  c" },
+    { "abcd\tc", 4, "abcd    c" },
+    { "私\tc\n\tx", 4, "私  c\n    x" },
+    { "\ta", 0, "        a" },
+  }
+
+  for _, d := range data {
+    output := ExpandTabs( d.input, d.n )
+    if output != d.output {
+      t.Errorf( "ExpandTabs( %q, %d ) \nreturn   %q\nexpected %q", d.input, d.n, output, d.output )
+    }
+  }
+}
@@ -0,0 +1,145 @@
+package txt
+
+import (
+  "strings"
+  "unicode"
+  "unicode/utf8"
+)
+
+type DiffChunk struct {
+  Op   DiffOp
+  Text string
+}
+
+// TokenizeWithSpaces splits str as Tokenize does, keeping every run of
+// spaces as a token of its own, so joining the tokens gives str back
+func TokenizeWithSpaces( str string ) []string {
+  r := make( []string, 0, 32 )
+
+  for i, w := 0, 0; i < len( str ); i += w {
+    if w = CountInitSpaces( str[i:] ); w == 0 { w = CountInitChars( str[i:] ) }
+    r = append( r, str[i:i + w] )
+  }
+
+  return r
+}
+
+// Graphemes splits str into user perceived characters: a rune with its
+// combining marks, variation selectors and emoji modifiers, emoji joined by
+// zero width joiners, regional indicator pairs, hangul syllable sequences
+// and CR LF
+func Graphemes( str string ) []string {
+  r := make( []string, 0, len( str ) )
+
+  for i := 0; i < len( str ); {
+    c, w := utf8.DecodeRuneInString( str[i:] )
+    j, prev, ri := i + w, c, isRegionalIndicator( c )
+
+    for j < len( str ) {
+      n, nw := utf8.DecodeRuneInString( str[j:] )
+
+      join := false
+      switch {
+      case prev == '\r': join = n == '\n'
+      case prev == '\n' || prev < 0x20: join = false
+      case prev == 0x200d: join = !unicode.IsSpace( n ) && !unicode.IsControl( n )
+      case isGraphemeExtend( n ): join = true
+      case ri: join = isRegionalIndicator( n )
+      default: join = hangulJoins( prev, n )
+      }
+
+      if !join { break }
+
+      ri   = false
+      prev = n
+      j   += nw
+    }
+
+    r = append( r, str[i:j] )
+    i = j
+  }
+
+  return r
+}
+
+func isGraphemeExtend( c rune ) bool {
+  switch {
+  case c == 0x200d, c == 0x200c: return true
+  case c >= 0x1f3fb && c <= 0x1f3ff: return true
+  case c >= 0xe0020 && c <= 0xe007f: return true
+  }
+
+  return unicode.In( c, unicode.Mn, unicode.Me, unicode.Mc )
+}
+
+func isRegionalIndicator( c rune ) bool {
+  return c >= 0x1f1e6 && c <= 0x1f1ff
+}
+
+func hangulJoins( prev, c rune ) bool {
+  isL  := func( c rune ) bool { return c >= 0x1100 && c <= 0x115f || c >= 0xa960 && c <= 0xa97c }
+  isV  := func( c rune ) bool { return c >= 0x1160 && c <= 0x11a7 || c >= 0xd7b0 && c <= 0xd7c6 }
+  isT  := func( c rune ) bool { return c >= 0x11a8 && c <= 0x11ff || c >= 0xd7cb && c <= 0xd7fb }
+  isS  := func( c rune ) bool { return c >= 0xac00 && c <= 0xd7a3 }
+  isLV := func( c rune ) bool { return isS( c ) && (c - 0xac00) % 28 == 0 }
+
+  switch {
+  case isL( prev ):              return isL( c ) || isV( c ) || isS( c )
+  case isV( prev ), isLV( prev ): return isV( c ) || isT( c )
+  case isT( prev ), isS( prev ):  return isT( c )
+  }
+
+  return false
+}
+
+// WordDiff compares a and b by TokenizeWithSpaces tokens
+func WordDiff( a, b string ) []DiffChunk {
+  return diffChunks( TokenizeWithSpaces( a ), TokenizeWithSpaces( b ) )
+}
+
+// CharDiff compares a and b by grapheme clusters
+func CharDiff( a, b string ) []DiffChunk {
+  return diffChunks( Graphemes( a ), Graphemes( b ) )
+}
+
+func diffChunks( a, b []string ) []DiffChunk {
+  edits := DiffStrings( a, b, Myers )
+  r     := make( []DiffChunk, 0, len( edits ) )
+
+  for _, e := range edits {
+    text := ""
+    if e.Op == DiffInsert {
+      text = strings.Join( b[e.BStart:e.BEnd], "" )
+    } else {
+      text = strings.Join( a[e.AStart:e.AEnd], "" )
+    }
+
+    r = append( r, DiffChunk{ e.Op, text } )
+  }
+
+  return r
+}
+
+// FormatInlineDiff marks deletions as [-text-] and insertions as {+text+}
+func FormatInlineDiff( chunks []DiffChunk ) string {
+  return formatDiffChunks( chunks, "[-", "-]", "{+", "+}" )
+}
+
+// FormatANSIDiff colors deletions in red and insertions in green
+func FormatANSIDiff( chunks []DiffChunk ) string {
+  return formatDiffChunks( chunks, "\x1b[31m", "\x1b[0m", "\x1b[32m", "\x1b[0m" )
+}
+
+func formatDiffChunks( chunks []DiffChunk, delOpen, delClose, insOpen, insClose string ) string {
+  var k strings.Builder
+
+  for _, c := range chunks {
+    switch c.Op {
+    case DiffDelete: k.WriteString( delOpen + c.Text + delClose )
+    case DiffInsert: k.WriteString( insOpen + c.Text + insClose )
+    default:         k.WriteString( c.Text )
+    }
+  }
+
+  return k.String()
+}
@@ -0,0 +1,90 @@
+package txt
+
+import "testing"
+
+func TestTokenizeWithSpaces( t *testing.T ){
+  data := []struct{
+    input    string
+    output   []string
+  } {
+    { "", []string{} },
+    { "a", []string{ "a" } },
+    { " a  b\n", []string{ " ", "a", "  ", "b", "\n" } },
+    { "hola,\n que\t\v tal!", []string{ "hola,", "\n ", "que", "\t\v ", "tal!" } },
+  }
+
+  for _, d := range data {
+    output := TokenizeWithSpaces( d.input )
+    if !cmpStringArray( output, d.output ) {
+      t.Errorf( "TokenizeWithSpaces( %q ) \nreturn   %q\nexpected %q", d.input, output, d.output )
+    }
+  }
+}
+
+func TestGraphemes( t *testing.T ){
+  data := []struct{
+    input    string
+    output   []string
+  } {
+    { "", []string{} },
+    { "abc", []string{ "a", "b", "c" } },
+    { "ñé", []string{ "ñ", "é" } },
+    { "a\r\nb\n\n", []string{ "a", "\r\n", "b", "\n", "\n" } },
+    { "👍🏽!", []string{ "👍🏽", "!" } },
+    { "👨‍👩‍👧x", []string{ "👨‍👩‍👧", "x" } },
+    { "🇲🇽🇪🇸🇦", []string{ "🇲🇽", "🇪🇸", "🇦" } },
+    { "각한", []string{ "각", "한" } },
+    { "♥️", []string{ "♥️" } },
+    { "नमस्ते", []string{ "न", "म", "स्", "ते" } },
+  }
+
+  for _, d := range data {
+    output := Graphemes( d.input )
+    if !cmpStringArray( output, d.output ) {
+      t.Errorf( "Graphemes( %q ) \nreturn   %q\nexpected %q", d.input, output, d.output )
+    }
+  }
+}
+
+func TestWordDiff( t *testing.T ){
+  data := []struct{
+    a, b     string
+    output   string
+  } {
+    { "", "", "" },
+    { "uno dos", "uno dos", "uno dos" },
+    { "uno dos tres", "uno tres", "uno [-dos -]tres" },
+    { "the quick fox", "the slow fox", "the [-quick-]{+slow+} fox" },
+    { "a  b", "a b", "a[-  -]{+ +}b" },
+  }
+
+  for _, d := range data {
+    output := FormatInlineDiff( WordDiff( d.a, d.b ) )
+    if output != d.output {
+      t.Errorf( "FormatInlineDiff( WordDiff( %q, %q ) ) \nreturn   %q\nexpected %q", d.a, d.b, output, d.output )
+    }
+  }
+}
+
+func TestCharDiff( t *testing.T ){
+  data := []struct{
+    a, b     string
+    output   string
+  } {
+    { "gato", "pato", "[-g-]{+p+}ato" },
+    { "café", "cafe", "caf[-é-]{+e+}" },
+    { "año", "ano", "a[-ñ-]{+n+}o" },
+  }
+
+  for _, d := range data {
+    output := FormatInlineDiff( CharDiff( d.a, d.b ) )
+    if output != d.output {
+      t.Errorf( "FormatInlineDiff( CharDiff( %q, %q ) ) \nreturn   %q\nexpected %q", d.a, d.b, output, d.output )
+    }
+  }
+
+  output := FormatANSIDiff( CharDiff( "gato", "pato" ) )
+  if output != "\x1b[31mg\x1b[0m\x1b[32mp\x1b[0mato" {
+    t.Errorf( "FormatANSIDiff( CharDiff( %q, %q ) ) \nreturn   %q", "gato", "pato", output )
+  }
+}